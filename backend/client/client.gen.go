@@ -0,0 +1,3554 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// CastMember defines model for CastMember.
+type CastMember struct {
+	Character string `json:"character"`
+	Name      string `json:"name"`
+}
+
+// CreatePartyRequest defines model for CreatePartyRequest.
+type CreatePartyRequest struct {
+	Name     string              `json:"name"`
+	Settings *PartySettingsInput `json:"settings,omitempty"`
+}
+
+// CreatePartyResponse defines model for CreatePartyResponse.
+type CreatePartyResponse struct {
+	AuthToken string `json:"auth_token"`
+	HostId    string `json:"host_id"`
+	Party     Party  `json:"party"`
+	PartyId   string `json:"party_id"`
+}
+
+// ErrorPayload defines model for ErrorPayload.
+type ErrorPayload struct {
+	Code    string                  `json:"code"`
+	Details *map[string]interface{} `json:"details,omitempty"`
+	Message string                  `json:"message"`
+}
+
+// HealthResponse defines model for HealthResponse.
+type HealthResponse struct {
+	Message   string `json:"message"`
+	Status    string `json:"status"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// JoinPartyRequest defines model for JoinPartyRequest.
+type JoinPartyRequest struct {
+	Username string `json:"username"`
+}
+
+// JoinPartyResponse defines model for JoinPartyResponse.
+type JoinPartyResponse struct {
+	AuthToken   string      `json:"auth_token"`
+	Participant Participant `json:"participant"`
+	Party       Party       `json:"party"`
+	UserId      string      `json:"user_id"`
+}
+
+// Movie defines model for Movie.
+type Movie struct {
+	Cast          *[]CastMember `json:"cast,omitempty"`
+	Certification *string       `json:"certification,omitempty"`
+	ContentType   string        `json:"content_type"`
+	Director      *string       `json:"director,omitempty"`
+	EpisodeCount  *int32        `json:"episode_count,omitempty"`
+	EpisodeNumber *int32        `json:"episode_number,omitempty"`
+	Genres        *[]string     `json:"genres,omitempty"`
+	Id            string        `json:"id"`
+	Overview      *string       `json:"overview,omitempty"`
+	PosterPath    string        `json:"poster_path"`
+	Rating        *float64      `json:"rating,omitempty"`
+	Runtime       *int32        `json:"runtime,omitempty"`
+	SeasonCount   *int32        `json:"season_count,omitempty"`
+	SeasonNumber  *int32        `json:"season_number,omitempty"`
+	ShowTitle     *string       `json:"show_title,omitempty"`
+	Title         string        `json:"title"`
+	TrailerKey    *string       `json:"trailer_key,omitempty"`
+	Year          string        `json:"year"`
+}
+
+// NominationVote defines model for NominationVote.
+type NominationVote struct {
+	Deadline    *time.Time        `json:"deadline"`
+	Movie       Movie             `json:"movie"`
+	SuggestedBy string            `json:"suggested_by"`
+	Voters      map[string]string `json:"voters"`
+}
+
+// Participant defines model for Participant.
+type Participant struct {
+	Id       string  `json:"id"`
+	IsHost   bool    `json:"is_host"`
+	Username string  `json:"username"`
+	Weight   float64 `json:"weight"`
+}
+
+// Party defines model for Party.
+type Party struct {
+	Approvals         *map[string][]string   `json:"approvals,omitempty"`
+	CreatedAt         time.Time              `json:"created_at"`
+	CurrentNomination NominationVote         `json:"current_nomination"`
+	Id                string                 `json:"id"`
+	Name              string                 `json:"name"`
+	NoOpinions        *map[string][]string   `json:"no_opinions,omitempty"`
+	NominationPool    []Movie                `json:"nomination_pool"`
+	Participants      map[string]Participant `json:"participants"`
+	Phase             string                 `json:"phase"`
+	PreEliminated     *[]Movie               `json:"pre_eliminated,omitempty"`
+	RankingDeadline   *time.Time             `json:"ranking_deadline"`
+	Results           *Results               `json:"results,omitempty"`
+	Rounds            *[]Round               `json:"rounds,omitempty"`
+	Runoff            *Runoff                `json:"runoff,omitempty"`
+	Settings          PartySettings          `json:"settings"`
+	Submissions       map[string][]string    `json:"submissions"`
+	SuggestionCounts  *map[string]int32      `json:"suggestion_counts,omitempty"`
+	Vetoes            *[]Veto                `json:"vetoes,omitempty"`
+	VetoesUsed        *map[string]int32      `json:"vetoes_used,omitempty"`
+	Winner            Movie                  `json:"winner"`
+	Winners           *[]Movie               `json:"winners,omitempty"`
+}
+
+// PartySettings defines model for PartySettings.
+type PartySettings struct {
+	AllowPartialRanking   bool    `json:"allow_partial_ranking"`
+	AnonymousVetoes       bool    `json:"anonymous_vetoes"`
+	HostClosesVoting      bool    `json:"host_closes_voting"`
+	HostDisplayName       string  `json:"host_display_name"`
+	HostVeto              bool    `json:"host_veto"`
+	MaxParticipants       int32   `json:"max_participants"`
+	MaxPoolSize           int32   `json:"max_pool_size"`
+	MaxSuggestionsPerUser int32   `json:"max_suggestions_per_user"`
+	NominationQuorum      float64 `json:"nomination_quorum"`
+	NominationRule        string  `json:"nomination_rule"`
+	NominationThreshold   float64 `json:"nomination_threshold"`
+	NominationVoteSeconds int32   `json:"nomination_vote_seconds"`
+	NominationYayCount    int32   `json:"nomination_yay_count"`
+	RankingSeconds        int32   `json:"ranking_seconds"`
+	RunoffOnTie           bool    `json:"runoff_on_tie"`
+	RunoffSeconds         int32   `json:"runoff_seconds"`
+	SecretBallots         bool    `json:"secret_ballots"`
+	VetoesPerUser         int32   `json:"vetoes_per_user"`
+	VotingMethod          string  `json:"voting_method"`
+	WinnerCount           int32   `json:"winner_count"`
+}
+
+// PartySettingsInput defines model for PartySettingsInput.
+type PartySettingsInput struct {
+	AllowPartialRanking   *bool    `json:"allow_partial_ranking,omitempty"`
+	AnonymousVetoes       *bool    `json:"anonymous_vetoes,omitempty"`
+	HostClosesVoting      *bool    `json:"host_closes_voting,omitempty"`
+	HostDisplayName       *string  `json:"host_display_name,omitempty"`
+	HostVeto              *bool    `json:"host_veto,omitempty"`
+	MaxParticipants       *int32   `json:"max_participants,omitempty"`
+	MaxPoolSize           *int32   `json:"max_pool_size,omitempty"`
+	MaxSuggestionsPerUser *int32   `json:"max_suggestions_per_user,omitempty"`
+	NominationQuorum      *float64 `json:"nomination_quorum,omitempty"`
+	NominationRule        *string  `json:"nomination_rule,omitempty"`
+	NominationThreshold   *float64 `json:"nomination_threshold,omitempty"`
+	NominationVoteSeconds *int32   `json:"nomination_vote_seconds,omitempty"`
+	NominationYayCount    *int32   `json:"nomination_yay_count,omitempty"`
+	RankingSeconds        *int32   `json:"ranking_seconds,omitempty"`
+	RunoffOnTie           *bool    `json:"runoff_on_tie,omitempty"`
+	RunoffSeconds         *int32   `json:"runoff_seconds,omitempty"`
+	SecretBallots         *bool    `json:"secret_ballots,omitempty"`
+	VetoesPerUser         *int32   `json:"vetoes_per_user,omitempty"`
+	VotingMethod          *string  `json:"voting_method,omitempty"`
+	WinnerCount           *int32   `json:"winner_count,omitempty"`
+}
+
+// RematchPayload defines model for RematchPayload.
+type RematchPayload struct {
+	CarryOverPool *bool  `json:"carry_over_pool,omitempty"`
+	Phase         string `json:"phase"`
+}
+
+// Results defines model for Results.
+type Results struct {
+	BallotCount int32               `json:"ballot_count"`
+	ClosedAt    time.Time           `json:"closed_at"`
+	ClosedBy    string              `json:"closed_by"`
+	Method      string              `json:"method"`
+	NonVoters   []string            `json:"non_voters"`
+	TieBreak    *string             `json:"tie_break,omitempty"`
+	Weights     *map[string]float64 `json:"weights,omitempty"`
+}
+
+// Round defines model for Round.
+type Round struct {
+	NominationPool []Movie       `json:"nomination_pool"`
+	Number         int32         `json:"number"`
+	Results        Results       `json:"results"`
+	Settings       PartySettings `json:"settings"`
+	Winners        []Movie       `json:"winners"`
+}
+
+// Runoff defines model for Runoff.
+type Runoff struct {
+	Deadline *time.Time        `json:"deadline"`
+	Movies   []Movie           `json:"movies"`
+	Votes    map[string]string `json:"votes"`
+}
+
+// RunoffVotePayload defines model for RunoffVotePayload.
+type RunoffVotePayload struct {
+	MovieId string `json:"movie_id"`
+}
+
+// SearchResultsPayload defines model for SearchResultsPayload.
+type SearchResultsPayload struct {
+	Movies       []Movie `json:"movies"`
+	Page         int32   `json:"page"`
+	Query        string  `json:"query"`
+	TotalPages   int32   `json:"total_pages"`
+	TotalResults int32   `json:"total_results"`
+}
+
+// SetVoterWeightPayload defines model for SetVoterWeightPayload.
+type SetVoterWeightPayload struct {
+	UserId string  `json:"user_id"`
+	Weight float64 `json:"weight"`
+}
+
+// SubmitApprovalsPayload defines model for SubmitApprovalsPayload.
+type SubmitApprovalsPayload struct {
+	MovieIds *[]string `json:"movie_ids,omitempty"`
+}
+
+// SubmitRankingPayload defines model for SubmitRankingPayload.
+type SubmitRankingPayload struct {
+	NoOpinion *[]string `json:"no_opinion,omitempty"`
+	Ranks     []string  `json:"ranks"`
+}
+
+// SuggestMoviePayload defines model for SuggestMoviePayload.
+type SuggestMoviePayload struct {
+	TmdbId string `json:"tmdb_id"`
+}
+
+// Veto defines model for Veto.
+type Veto struct {
+	At       time.Time `json:"at"`
+	Movie    Movie     `json:"movie"`
+	Phase    string    `json:"phase"`
+	Target   string    `json:"target"`
+	VetoedBy *string   `json:"vetoed_by,omitempty"`
+}
+
+// VetoPayload defines model for VetoPayload.
+type VetoPayload struct {
+	MovieId string `json:"movie_id"`
+}
+
+// VotePayload defines model for VotePayload.
+type VotePayload struct {
+	Vote string `json:"vote"`
+}
+
+// SearchMoviesParams defines parameters for SearchMovies.
+type SearchMoviesParams struct {
+	IncludeAdult       *bool   `form:"include_adult,omitempty" json:"include_adult,omitempty"`
+	Language           *string `form:"language,omitempty" json:"language,omitempty"`
+	MediaType          *string `form:"media_type,omitempty" json:"media_type,omitempty"`
+	Page               *int32  `form:"page,omitempty" json:"page,omitempty"`
+	PrimaryReleaseYear *int32  `form:"primary_release_year,omitempty" json:"primary_release_year,omitempty"`
+	Q                  string  `form:"q" json:"q"`
+	Region             *string `form:"region,omitempty" json:"region,omitempty"`
+	Year               *int32  `form:"year,omitempty" json:"year,omitempty"`
+}
+
+// CreatePartyJSONRequestBody defines body for CreateParty for application/json ContentType.
+type CreatePartyJSONRequestBody = CreatePartyRequest
+
+// SubmitApprovalsJSONRequestBody defines body for SubmitApprovals for application/json ContentType.
+type SubmitApprovalsJSONRequestBody = SubmitApprovalsPayload
+
+// JoinPartyJSONRequestBody defines body for JoinParty for application/json ContentType.
+type JoinPartyJSONRequestBody = JoinPartyRequest
+
+// SubmitRankingJSONRequestBody defines body for SubmitRanking for application/json ContentType.
+type SubmitRankingJSONRequestBody = SubmitRankingPayload
+
+// RematchJSONRequestBody defines body for Rematch for application/json ContentType.
+type RematchJSONRequestBody = RematchPayload
+
+// DecideRunoffJSONRequestBody defines body for DecideRunoff for application/json ContentType.
+type DecideRunoffJSONRequestBody = RunoffVotePayload
+
+// VoteRunoffJSONRequestBody defines body for VoteRunoff for application/json ContentType.
+type VoteRunoffJSONRequestBody = RunoffVotePayload
+
+// UpdateSettingsJSONRequestBody defines body for UpdateSettings for application/json ContentType.
+type UpdateSettingsJSONRequestBody = PartySettingsInput
+
+// SuggestMovieJSONRequestBody defines body for SuggestMovie for application/json ContentType.
+type SuggestMovieJSONRequestBody = SuggestMoviePayload
+
+// VetoMovieJSONRequestBody defines body for VetoMovie for application/json ContentType.
+type VetoMovieJSONRequestBody = VetoPayload
+
+// VoteNominationJSONRequestBody defines body for VoteNomination for application/json ContentType.
+type VoteNominationJSONRequestBody = VotePayload
+
+// SetVoterWeightJSONRequestBody defines body for SetVoterWeight for application/json ContentType.
+type SetVoterWeightJSONRequestBody = SetVoterWeightPayload
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// HealthCheck request
+	HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchMovies request
+	SearchMovies(ctx context.Context, params *SearchMoviesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOpenAPI request
+	GetOpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePartyWithBody request with any body
+	CreatePartyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateParty(ctx context.Context, body CreatePartyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetParty request
+	GetParty(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitApprovalsWithBody request with any body
+	SubmitApprovalsWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SubmitApprovals(ctx context.Context, id string, body SubmitApprovalsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CloseApproval request
+	CloseApproval(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CloseRanking request
+	CloseRanking(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CloseVoting request
+	CloseVoting(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FinalizeNominations request
+	FinalizeNominations(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// JoinPartyWithBody request with any body
+	JoinPartyWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	JoinParty(ctx context.Context, id string, body JoinPartyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitRankingWithBody request with any body
+	SubmitRankingWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SubmitRanking(ctx context.Context, id string, body SubmitRankingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RematchWithBody request with any body
+	RematchWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Rematch(ctx context.Context, id string, body RematchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DecideRunoffWithBody request with any body
+	DecideRunoffWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DecideRunoff(ctx context.Context, id string, body DecideRunoffJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VoteRunoffWithBody request with any body
+	VoteRunoffWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VoteRunoff(ctx context.Context, id string, body VoteRunoffJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSettingsWithBody request with any body
+	UpdateSettingsWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSettings(ctx context.Context, id string, body UpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartNomination request
+	StartNomination(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SuggestMovieWithBody request with any body
+	SuggestMovieWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SuggestMovie(ctx context.Context, id string, body SuggestMovieJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VetoMovieWithBody request with any body
+	VetoMovieWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VetoMovie(ctx context.Context, id string, body VetoMovieJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RetractVote request
+	RetractVote(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VoteNominationWithBody request with any body
+	VoteNominationWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VoteNomination(ctx context.Context, id string, body VoteNominationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetVoterWeightWithBody request with any body
+	SetVoterWeightWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetVoterWeight(ctx context.Context, id string, body SetVoterWeightJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthCheckRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchMovies(ctx context.Context, params *SearchMoviesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchMoviesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOpenAPI(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOpenAPIRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePartyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePartyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateParty(ctx context.Context, body CreatePartyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePartyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetParty(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPartyRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitApprovalsWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitApprovalsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitApprovals(ctx context.Context, id string, body SubmitApprovalsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitApprovalsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CloseApproval(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloseApprovalRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CloseRanking(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloseRankingRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CloseVoting(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloseVotingRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FinalizeNominations(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFinalizeNominationsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) JoinPartyWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewJoinPartyRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) JoinParty(ctx context.Context, id string, body JoinPartyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewJoinPartyRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitRankingWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitRankingRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitRanking(ctx context.Context, id string, body SubmitRankingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitRankingRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RematchWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRematchRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Rematch(ctx context.Context, id string, body RematchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRematchRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DecideRunoffWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDecideRunoffRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DecideRunoff(ctx context.Context, id string, body DecideRunoffJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDecideRunoffRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VoteRunoffWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVoteRunoffRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VoteRunoff(ctx context.Context, id string, body VoteRunoffJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVoteRunoffRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSettingsWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSettingsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSettings(ctx context.Context, id string, body UpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSettingsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartNomination(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartNominationRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SuggestMovieWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSuggestMovieRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SuggestMovie(ctx context.Context, id string, body SuggestMovieJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSuggestMovieRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VetoMovieWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVetoMovieRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VetoMovie(ctx context.Context, id string, body VetoMovieJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVetoMovieRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RetractVote(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRetractVoteRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VoteNominationWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVoteNominationRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VoteNomination(ctx context.Context, id string, body VoteNominationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVoteNominationRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetVoterWeightWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetVoterWeightRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetVoterWeight(ctx context.Context, id string, body SetVoterWeightJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetVoterWeightRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewHealthCheckRequest generates requests for HealthCheck
+func NewHealthCheckRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchMoviesRequest generates requests for SearchMovies
+func NewSearchMoviesRequest(server string, params *SearchMoviesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/movies/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeAdult != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_adult", runtime.ParamLocationQuery, *params.IncludeAdult); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Language != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "language", runtime.ParamLocationQuery, *params.Language); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MediaType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "media_type", runtime.ParamLocationQuery, *params.MediaType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PrimaryReleaseYear != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "primary_release_year", runtime.ParamLocationQuery, *params.PrimaryReleaseYear); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Region != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "region", runtime.ParamLocationQuery, *params.Region); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Year != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "year", runtime.ParamLocationQuery, *params.Year); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOpenAPIRequest generates requests for GetOpenAPI
+func NewGetOpenAPIRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/openapi.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePartyRequest calls the generic CreateParty builder with application/json body
+func NewCreatePartyRequest(server string, body CreatePartyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePartyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePartyRequestWithBody generates requests for CreateParty with any type of body
+func NewCreatePartyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPartyRequest generates requests for GetParty
+func NewGetPartyRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubmitApprovalsRequest calls the generic SubmitApprovals builder with application/json body
+func NewSubmitApprovalsRequest(server string, id string, body SubmitApprovalsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitApprovalsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSubmitApprovalsRequestWithBody generates requests for SubmitApprovals with any type of body
+func NewSubmitApprovalsRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/approvals", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCloseApprovalRequest generates requests for CloseApproval
+func NewCloseApprovalRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/close-approval", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCloseRankingRequest generates requests for CloseRanking
+func NewCloseRankingRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/close-ranking", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCloseVotingRequest generates requests for CloseVoting
+func NewCloseVotingRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/close-voting", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFinalizeNominationsRequest generates requests for FinalizeNominations
+func NewFinalizeNominationsRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/finalize-nominations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewJoinPartyRequest calls the generic JoinParty builder with application/json body
+func NewJoinPartyRequest(server string, id string, body JoinPartyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewJoinPartyRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewJoinPartyRequestWithBody generates requests for JoinParty with any type of body
+func NewJoinPartyRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/join", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSubmitRankingRequest calls the generic SubmitRanking builder with application/json body
+func NewSubmitRankingRequest(server string, id string, body SubmitRankingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitRankingRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSubmitRankingRequestWithBody generates requests for SubmitRanking with any type of body
+func NewSubmitRankingRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/ranking", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRematchRequest calls the generic Rematch builder with application/json body
+func NewRematchRequest(server string, id string, body RematchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRematchRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRematchRequestWithBody generates requests for Rematch with any type of body
+func NewRematchRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/rematch", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDecideRunoffRequest calls the generic DecideRunoff builder with application/json body
+func NewDecideRunoffRequest(server string, id string, body DecideRunoffJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDecideRunoffRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewDecideRunoffRequestWithBody generates requests for DecideRunoff with any type of body
+func NewDecideRunoffRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/runoff/decide", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVoteRunoffRequest calls the generic VoteRunoff builder with application/json body
+func NewVoteRunoffRequest(server string, id string, body VoteRunoffJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVoteRunoffRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewVoteRunoffRequestWithBody generates requests for VoteRunoff with any type of body
+func NewVoteRunoffRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/runoff/vote", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateSettingsRequest calls the generic UpdateSettings builder with application/json body
+func NewUpdateSettingsRequest(server string, id string, body UpdateSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSettingsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateSettingsRequestWithBody generates requests for UpdateSettings with any type of body
+func NewUpdateSettingsRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/settings", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewStartNominationRequest generates requests for StartNomination
+func NewStartNominationRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/start-nomination", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSuggestMovieRequest calls the generic SuggestMovie builder with application/json body
+func NewSuggestMovieRequest(server string, id string, body SuggestMovieJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSuggestMovieRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSuggestMovieRequestWithBody generates requests for SuggestMovie with any type of body
+func NewSuggestMovieRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/suggest", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVetoMovieRequest calls the generic VetoMovie builder with application/json body
+func NewVetoMovieRequest(server string, id string, body VetoMovieJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVetoMovieRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewVetoMovieRequestWithBody generates requests for VetoMovie with any type of body
+func NewVetoMovieRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/veto", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRetractVoteRequest generates requests for RetractVote
+func NewRetractVoteRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/vote", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewVoteNominationRequest calls the generic VoteNomination builder with application/json body
+func NewVoteNominationRequest(server string, id string, body VoteNominationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVoteNominationRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewVoteNominationRequestWithBody generates requests for VoteNomination with any type of body
+func NewVoteNominationRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/vote", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetVoterWeightRequest calls the generic SetVoterWeight builder with application/json body
+func NewSetVoterWeightRequest(server string, id string, body SetVoterWeightJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetVoterWeightRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetVoterWeightRequestWithBody generates requests for SetVoterWeight with any type of body
+func NewSetVoterWeightRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/party/%s/voter-weight", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// HealthCheckWithResponse request
+	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResult, error)
+
+	// SearchMoviesWithResponse request
+	SearchMoviesWithResponse(ctx context.Context, params *SearchMoviesParams, reqEditors ...RequestEditorFn) (*SearchMoviesResult, error)
+
+	// GetOpenAPIWithResponse request
+	GetOpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPIResult, error)
+
+	// CreatePartyWithBodyWithResponse request with any body
+	CreatePartyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePartyResult, error)
+
+	CreatePartyWithResponse(ctx context.Context, body CreatePartyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePartyResult, error)
+
+	// GetPartyWithResponse request
+	GetPartyWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetPartyResult, error)
+
+	// SubmitApprovalsWithBodyWithResponse request with any body
+	SubmitApprovalsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitApprovalsResult, error)
+
+	SubmitApprovalsWithResponse(ctx context.Context, id string, body SubmitApprovalsJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitApprovalsResult, error)
+
+	// CloseApprovalWithResponse request
+	CloseApprovalWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*CloseApprovalResult, error)
+
+	// CloseRankingWithResponse request
+	CloseRankingWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*CloseRankingResult, error)
+
+	// CloseVotingWithResponse request
+	CloseVotingWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*CloseVotingResult, error)
+
+	// FinalizeNominationsWithResponse request
+	FinalizeNominationsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*FinalizeNominationsResult, error)
+
+	// JoinPartyWithBodyWithResponse request with any body
+	JoinPartyWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*JoinPartyResult, error)
+
+	JoinPartyWithResponse(ctx context.Context, id string, body JoinPartyJSONRequestBody, reqEditors ...RequestEditorFn) (*JoinPartyResult, error)
+
+	// SubmitRankingWithBodyWithResponse request with any body
+	SubmitRankingWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitRankingResult, error)
+
+	SubmitRankingWithResponse(ctx context.Context, id string, body SubmitRankingJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitRankingResult, error)
+
+	// RematchWithBodyWithResponse request with any body
+	RematchWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RematchResult, error)
+
+	RematchWithResponse(ctx context.Context, id string, body RematchJSONRequestBody, reqEditors ...RequestEditorFn) (*RematchResult, error)
+
+	// DecideRunoffWithBodyWithResponse request with any body
+	DecideRunoffWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DecideRunoffResult, error)
+
+	DecideRunoffWithResponse(ctx context.Context, id string, body DecideRunoffJSONRequestBody, reqEditors ...RequestEditorFn) (*DecideRunoffResult, error)
+
+	// VoteRunoffWithBodyWithResponse request with any body
+	VoteRunoffWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VoteRunoffResult, error)
+
+	VoteRunoffWithResponse(ctx context.Context, id string, body VoteRunoffJSONRequestBody, reqEditors ...RequestEditorFn) (*VoteRunoffResult, error)
+
+	// UpdateSettingsWithBodyWithResponse request with any body
+	UpdateSettingsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSettingsResult, error)
+
+	UpdateSettingsWithResponse(ctx context.Context, id string, body UpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSettingsResult, error)
+
+	// StartNominationWithResponse request
+	StartNominationWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StartNominationResult, error)
+
+	// SuggestMovieWithBodyWithResponse request with any body
+	SuggestMovieWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SuggestMovieResult, error)
+
+	SuggestMovieWithResponse(ctx context.Context, id string, body SuggestMovieJSONRequestBody, reqEditors ...RequestEditorFn) (*SuggestMovieResult, error)
+
+	// VetoMovieWithBodyWithResponse request with any body
+	VetoMovieWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VetoMovieResult, error)
+
+	VetoMovieWithResponse(ctx context.Context, id string, body VetoMovieJSONRequestBody, reqEditors ...RequestEditorFn) (*VetoMovieResult, error)
+
+	// RetractVoteWithResponse request
+	RetractVoteWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RetractVoteResult, error)
+
+	// VoteNominationWithBodyWithResponse request with any body
+	VoteNominationWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VoteNominationResult, error)
+
+	VoteNominationWithResponse(ctx context.Context, id string, body VoteNominationJSONRequestBody, reqEditors ...RequestEditorFn) (*VoteNominationResult, error)
+
+	// SetVoterWeightWithBodyWithResponse request with any body
+	SetVoterWeightWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetVoterWeightResult, error)
+
+	SetVoterWeightWithResponse(ctx context.Context, id string, body SetVoterWeightJSONRequestBody, reqEditors ...RequestEditorFn) (*SetVoterWeightResult, error)
+}
+
+type HealthCheckResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthResponse
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthCheckResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthCheckResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchMoviesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchResultsPayload
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchMoviesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchMoviesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOpenAPIResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOpenAPIResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOpenAPIResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePartyResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatePartyResponse
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePartyResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePartyResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPartyResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPartyResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPartyResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitApprovalsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitApprovalsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitApprovalsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloseApprovalResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r CloseApprovalResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloseApprovalResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloseRankingResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r CloseRankingResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloseRankingResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloseVotingResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r CloseVotingResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloseVotingResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FinalizeNominationsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r FinalizeNominationsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FinalizeNominationsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type JoinPartyResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *JoinPartyResponse
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r JoinPartyResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r JoinPartyResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitRankingResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitRankingResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitRankingResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RematchResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r RematchResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RematchResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DecideRunoffResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r DecideRunoffResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DecideRunoffResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VoteRunoffResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r VoteRunoffResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VoteRunoffResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSettingsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSettingsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSettingsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartNominationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r StartNominationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartNominationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SuggestMovieResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r SuggestMovieResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SuggestMovieResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VetoMovieResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r VetoMovieResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VetoMovieResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RetractVoteResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r RetractVoteResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RetractVoteResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VoteNominationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r VoteNominationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VoteNominationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetVoterWeightResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Party
+	JSONDefault  *ErrorPayload
+}
+
+// Status returns HTTPResponse.Status
+func (r SetVoterWeightResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetVoterWeightResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HealthCheckWithResponse request returning *HealthCheckResult
+func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResult, error) {
+	rsp, err := c.HealthCheck(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthCheckResult(rsp)
+}
+
+// SearchMoviesWithResponse request returning *SearchMoviesResult
+func (c *ClientWithResponses) SearchMoviesWithResponse(ctx context.Context, params *SearchMoviesParams, reqEditors ...RequestEditorFn) (*SearchMoviesResult, error) {
+	rsp, err := c.SearchMovies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchMoviesResult(rsp)
+}
+
+// GetOpenAPIWithResponse request returning *GetOpenAPIResult
+func (c *ClientWithResponses) GetOpenAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPIResult, error) {
+	rsp, err := c.GetOpenAPI(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOpenAPIResult(rsp)
+}
+
+// CreatePartyWithBodyWithResponse request with arbitrary body returning *CreatePartyResult
+func (c *ClientWithResponses) CreatePartyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePartyResult, error) {
+	rsp, err := c.CreatePartyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePartyResult(rsp)
+}
+
+func (c *ClientWithResponses) CreatePartyWithResponse(ctx context.Context, body CreatePartyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePartyResult, error) {
+	rsp, err := c.CreateParty(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePartyResult(rsp)
+}
+
+// GetPartyWithResponse request returning *GetPartyResult
+func (c *ClientWithResponses) GetPartyWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetPartyResult, error) {
+	rsp, err := c.GetParty(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPartyResult(rsp)
+}
+
+// SubmitApprovalsWithBodyWithResponse request with arbitrary body returning *SubmitApprovalsResult
+func (c *ClientWithResponses) SubmitApprovalsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitApprovalsResult, error) {
+	rsp, err := c.SubmitApprovalsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitApprovalsResult(rsp)
+}
+
+func (c *ClientWithResponses) SubmitApprovalsWithResponse(ctx context.Context, id string, body SubmitApprovalsJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitApprovalsResult, error) {
+	rsp, err := c.SubmitApprovals(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitApprovalsResult(rsp)
+}
+
+// CloseApprovalWithResponse request returning *CloseApprovalResult
+func (c *ClientWithResponses) CloseApprovalWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*CloseApprovalResult, error) {
+	rsp, err := c.CloseApproval(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloseApprovalResult(rsp)
+}
+
+// CloseRankingWithResponse request returning *CloseRankingResult
+func (c *ClientWithResponses) CloseRankingWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*CloseRankingResult, error) {
+	rsp, err := c.CloseRanking(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloseRankingResult(rsp)
+}
+
+// CloseVotingWithResponse request returning *CloseVotingResult
+func (c *ClientWithResponses) CloseVotingWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*CloseVotingResult, error) {
+	rsp, err := c.CloseVoting(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloseVotingResult(rsp)
+}
+
+// FinalizeNominationsWithResponse request returning *FinalizeNominationsResult
+func (c *ClientWithResponses) FinalizeNominationsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*FinalizeNominationsResult, error) {
+	rsp, err := c.FinalizeNominations(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFinalizeNominationsResult(rsp)
+}
+
+// JoinPartyWithBodyWithResponse request with arbitrary body returning *JoinPartyResult
+func (c *ClientWithResponses) JoinPartyWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*JoinPartyResult, error) {
+	rsp, err := c.JoinPartyWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseJoinPartyResult(rsp)
+}
+
+func (c *ClientWithResponses) JoinPartyWithResponse(ctx context.Context, id string, body JoinPartyJSONRequestBody, reqEditors ...RequestEditorFn) (*JoinPartyResult, error) {
+	rsp, err := c.JoinParty(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseJoinPartyResult(rsp)
+}
+
+// SubmitRankingWithBodyWithResponse request with arbitrary body returning *SubmitRankingResult
+func (c *ClientWithResponses) SubmitRankingWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitRankingResult, error) {
+	rsp, err := c.SubmitRankingWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitRankingResult(rsp)
+}
+
+func (c *ClientWithResponses) SubmitRankingWithResponse(ctx context.Context, id string, body SubmitRankingJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitRankingResult, error) {
+	rsp, err := c.SubmitRanking(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitRankingResult(rsp)
+}
+
+// RematchWithBodyWithResponse request with arbitrary body returning *RematchResult
+func (c *ClientWithResponses) RematchWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RematchResult, error) {
+	rsp, err := c.RematchWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRematchResult(rsp)
+}
+
+func (c *ClientWithResponses) RematchWithResponse(ctx context.Context, id string, body RematchJSONRequestBody, reqEditors ...RequestEditorFn) (*RematchResult, error) {
+	rsp, err := c.Rematch(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRematchResult(rsp)
+}
+
+// DecideRunoffWithBodyWithResponse request with arbitrary body returning *DecideRunoffResult
+func (c *ClientWithResponses) DecideRunoffWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DecideRunoffResult, error) {
+	rsp, err := c.DecideRunoffWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDecideRunoffResult(rsp)
+}
+
+func (c *ClientWithResponses) DecideRunoffWithResponse(ctx context.Context, id string, body DecideRunoffJSONRequestBody, reqEditors ...RequestEditorFn) (*DecideRunoffResult, error) {
+	rsp, err := c.DecideRunoff(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDecideRunoffResult(rsp)
+}
+
+// VoteRunoffWithBodyWithResponse request with arbitrary body returning *VoteRunoffResult
+func (c *ClientWithResponses) VoteRunoffWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VoteRunoffResult, error) {
+	rsp, err := c.VoteRunoffWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVoteRunoffResult(rsp)
+}
+
+func (c *ClientWithResponses) VoteRunoffWithResponse(ctx context.Context, id string, body VoteRunoffJSONRequestBody, reqEditors ...RequestEditorFn) (*VoteRunoffResult, error) {
+	rsp, err := c.VoteRunoff(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVoteRunoffResult(rsp)
+}
+
+// UpdateSettingsWithBodyWithResponse request with arbitrary body returning *UpdateSettingsResult
+func (c *ClientWithResponses) UpdateSettingsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSettingsResult, error) {
+	rsp, err := c.UpdateSettingsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSettingsResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSettingsWithResponse(ctx context.Context, id string, body UpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSettingsResult, error) {
+	rsp, err := c.UpdateSettings(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSettingsResult(rsp)
+}
+
+// StartNominationWithResponse request returning *StartNominationResult
+func (c *ClientWithResponses) StartNominationWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StartNominationResult, error) {
+	rsp, err := c.StartNomination(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartNominationResult(rsp)
+}
+
+// SuggestMovieWithBodyWithResponse request with arbitrary body returning *SuggestMovieResult
+func (c *ClientWithResponses) SuggestMovieWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SuggestMovieResult, error) {
+	rsp, err := c.SuggestMovieWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSuggestMovieResult(rsp)
+}
+
+func (c *ClientWithResponses) SuggestMovieWithResponse(ctx context.Context, id string, body SuggestMovieJSONRequestBody, reqEditors ...RequestEditorFn) (*SuggestMovieResult, error) {
+	rsp, err := c.SuggestMovie(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSuggestMovieResult(rsp)
+}
+
+// VetoMovieWithBodyWithResponse request with arbitrary body returning *VetoMovieResult
+func (c *ClientWithResponses) VetoMovieWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VetoMovieResult, error) {
+	rsp, err := c.VetoMovieWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVetoMovieResult(rsp)
+}
+
+func (c *ClientWithResponses) VetoMovieWithResponse(ctx context.Context, id string, body VetoMovieJSONRequestBody, reqEditors ...RequestEditorFn) (*VetoMovieResult, error) {
+	rsp, err := c.VetoMovie(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVetoMovieResult(rsp)
+}
+
+// RetractVoteWithResponse request returning *RetractVoteResult
+func (c *ClientWithResponses) RetractVoteWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RetractVoteResult, error) {
+	rsp, err := c.RetractVote(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRetractVoteResult(rsp)
+}
+
+// VoteNominationWithBodyWithResponse request with arbitrary body returning *VoteNominationResult
+func (c *ClientWithResponses) VoteNominationWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VoteNominationResult, error) {
+	rsp, err := c.VoteNominationWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVoteNominationResult(rsp)
+}
+
+func (c *ClientWithResponses) VoteNominationWithResponse(ctx context.Context, id string, body VoteNominationJSONRequestBody, reqEditors ...RequestEditorFn) (*VoteNominationResult, error) {
+	rsp, err := c.VoteNomination(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVoteNominationResult(rsp)
+}
+
+// SetVoterWeightWithBodyWithResponse request with arbitrary body returning *SetVoterWeightResult
+func (c *ClientWithResponses) SetVoterWeightWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetVoterWeightResult, error) {
+	rsp, err := c.SetVoterWeightWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetVoterWeightResult(rsp)
+}
+
+func (c *ClientWithResponses) SetVoterWeightWithResponse(ctx context.Context, id string, body SetVoterWeightJSONRequestBody, reqEditors ...RequestEditorFn) (*SetVoterWeightResult, error) {
+	rsp, err := c.SetVoterWeight(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetVoterWeightResult(rsp)
+}
+
+// ParseHealthCheckResult parses an HTTP response from a HealthCheckWithResponse call
+func ParseHealthCheckResult(rsp *http.Response) (*HealthCheckResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HealthCheckResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchMoviesResult parses an HTTP response from a SearchMoviesWithResponse call
+func ParseSearchMoviesResult(rsp *http.Response) (*SearchMoviesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchMoviesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SearchResultsPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOpenAPIResult parses an HTTP response from a GetOpenAPIWithResponse call
+func ParseGetOpenAPIResult(rsp *http.Response) (*GetOpenAPIResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOpenAPIResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePartyResult parses an HTTP response from a CreatePartyWithResponse call
+func ParseCreatePartyResult(rsp *http.Response) (*CreatePartyResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePartyResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatePartyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPartyResult parses an HTTP response from a GetPartyWithResponse call
+func ParseGetPartyResult(rsp *http.Response) (*GetPartyResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPartyResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSubmitApprovalsResult parses an HTTP response from a SubmitApprovalsWithResponse call
+func ParseSubmitApprovalsResult(rsp *http.Response) (*SubmitApprovalsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SubmitApprovalsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCloseApprovalResult parses an HTTP response from a CloseApprovalWithResponse call
+func ParseCloseApprovalResult(rsp *http.Response) (*CloseApprovalResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CloseApprovalResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCloseRankingResult parses an HTTP response from a CloseRankingWithResponse call
+func ParseCloseRankingResult(rsp *http.Response) (*CloseRankingResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CloseRankingResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCloseVotingResult parses an HTTP response from a CloseVotingWithResponse call
+func ParseCloseVotingResult(rsp *http.Response) (*CloseVotingResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CloseVotingResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFinalizeNominationsResult parses an HTTP response from a FinalizeNominationsWithResponse call
+func ParseFinalizeNominationsResult(rsp *http.Response) (*FinalizeNominationsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FinalizeNominationsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseJoinPartyResult parses an HTTP response from a JoinPartyWithResponse call
+func ParseJoinPartyResult(rsp *http.Response) (*JoinPartyResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &JoinPartyResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest JoinPartyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSubmitRankingResult parses an HTTP response from a SubmitRankingWithResponse call
+func ParseSubmitRankingResult(rsp *http.Response) (*SubmitRankingResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SubmitRankingResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRematchResult parses an HTTP response from a RematchWithResponse call
+func ParseRematchResult(rsp *http.Response) (*RematchResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RematchResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDecideRunoffResult parses an HTTP response from a DecideRunoffWithResponse call
+func ParseDecideRunoffResult(rsp *http.Response) (*DecideRunoffResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DecideRunoffResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVoteRunoffResult parses an HTTP response from a VoteRunoffWithResponse call
+func ParseVoteRunoffResult(rsp *http.Response) (*VoteRunoffResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VoteRunoffResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSettingsResult parses an HTTP response from a UpdateSettingsWithResponse call
+func ParseUpdateSettingsResult(rsp *http.Response) (*UpdateSettingsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSettingsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartNominationResult parses an HTTP response from a StartNominationWithResponse call
+func ParseStartNominationResult(rsp *http.Response) (*StartNominationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartNominationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSuggestMovieResult parses an HTTP response from a SuggestMovieWithResponse call
+func ParseSuggestMovieResult(rsp *http.Response) (*SuggestMovieResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SuggestMovieResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVetoMovieResult parses an HTTP response from a VetoMovieWithResponse call
+func ParseVetoMovieResult(rsp *http.Response) (*VetoMovieResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VetoMovieResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRetractVoteResult parses an HTTP response from a RetractVoteWithResponse call
+func ParseRetractVoteResult(rsp *http.Response) (*RetractVoteResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RetractVoteResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVoteNominationResult parses an HTTP response from a VoteNominationWithResponse call
+func ParseVoteNominationResult(rsp *http.Response) (*VoteNominationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VoteNominationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetVoterWeightResult parses an HTTP response from a SetVoterWeightWithResponse call
+func ParseSetVoterWeightResult(rsp *http.Response) (*SetVoterWeightResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetVoterWeightResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Party
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorPayload
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
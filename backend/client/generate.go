@@ -0,0 +1,9 @@
+// Package client is a Go client for the ReelChoice REST API, generated from
+// the OpenAPI document that the server builds from its route table.
+//
+// Run `go generate ./client` from the backend directory after changing the
+// REST API to refresh openapi.json and client.gen.go.
+package client
+
+//go:generate sh -c "go run ../cmd/openapi > ../openapi.json"
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.1 -config oapi-codegen.yaml ../openapi.json
@@ -0,0 +1,19 @@
+// Command openapi prints the OpenAPI document for the REST API. It is run by
+// go generate in the client package to refresh openapi.json and the Go client.
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+
+	"github.com/reelchoice/backend/internal/api"
+)
+
+func main() {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(api.BuildOpenAPI()); err != nil {
+		log.Fatalf("Failed to write OpenAPI document: %v", err)
+	}
+}
@@ -2,7 +2,7 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
@@ -12,6 +12,10 @@ import (
 	"github.com/reelchoice/backend/internal/api"
 	"github.com/reelchoice/backend/internal/config"
 	"github.com/reelchoice/backend/internal/database"
+	"github.com/reelchoice/backend/internal/health"
+	"github.com/reelchoice/backend/internal/logging"
+	"github.com/reelchoice/backend/internal/metrics"
+	"github.com/reelchoice/backend/internal/tracing"
 	"github.com/reelchoice/backend/internal/websocket"
 
 	"github.com/go-chi/chi/v5"
@@ -21,34 +25,51 @@ import (
 func main() {
 	// Load configuration
 	cfg := config.LoadConfig()
-	log.Printf("Starting ReelChoice backend server on port %s", cfg.Port)
+
+	// Set up structured logging
+	logger, err := logging.New(os.Stdout, cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		fatal("Invalid logging configuration", err)
+	}
+	slog.SetDefault(logger)
+	slog.Info("Starting ReelChoice backend server", "port", cfg.Port)
+
+	// Set up tracing
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.TracingExporter, cfg.OTLPEndpoint)
+	if err != nil {
+		fatal("Invalid tracing configuration", err)
+	}
+	slog.Info("Tracing configured", "exporter", cfg.TracingExporter)
 
 	// Initialize Redis client
 	redisClient, err := database.NewRedisClient(cfg.RedisURL)
 	if err != nil {
-		log.Fatalf("Failed to connect to Redis: %v", err)
+		fatal("Failed to connect to Redis", err)
 	}
 	defer redisClient.Close()
-	log.Println("Connected to Redis successfully")
+	slog.Info("Connected to Redis successfully")
 
 	// Initialize PostgreSQL client
 	pgClient, err := database.NewPostgresClient(cfg.DatabaseURL)
 	if err != nil {
-		log.Fatalf("Failed to connect to PostgreSQL: %v", err)
+		fatal("Failed to connect to PostgreSQL", err)
 	}
 	defer pgClient.Close()
-	log.Println("Connected to PostgreSQL successfully")
+	slog.Info("Connected to PostgreSQL successfully")
 
 	// Create WebSocket hub
 	hub := websocket.NewHub(redisClient)
-	go hub.Run()
-	log.Println("WebSocket hub started")
+	hubCtx, stopHub := context.WithCancel(context.Background())
+	go hub.Run(hubCtx)
+	slog.Info("WebSocket hub started")
 
 	// Set up Chi router
 	r := chi.NewRouter()
 
 	// Middleware
-	r.Use(middleware.Logger)
+	r.Use(middleware.RequestID)
+	r.Use(tracing.Middleware)
+	r.Use(logging.Middleware)
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.Timeout(30 * time.Second))
 
@@ -67,22 +88,30 @@ func main() {
 		})
 	})
 
+	// Readiness checks; the API handlers add TMDB if enabled
+	checker := health.NewChecker(health.DefaultTimeout)
+	checker.Add("redis", redisClient.Ping, true)
+	checker.Add("postgres", pgClient.Ping, true)
+	checker.Add("websocket", hub.Ready, true)
+
 	// Create API handlers with dependencies
-	apiHandlers := api.NewHandlers(redisClient, hub, cfg)
+	apiHandlers := api.NewHandlers(redisClient, hub, cfg, checker)
 
 	// API routes
-	r.Route("/api", func(r chi.Router) {
-		r.Post("/party", apiHandlers.CreateParty)
-		r.Get("/party/{id}", apiHandlers.GetParty)
-		r.Post("/party/{id}/join", apiHandlers.JoinParty)
-		r.Post("/party/{id}/start-nomination", apiHandlers.StartNomination)
-		r.Get("/movies/search", apiHandlers.SearchMovies)
-		r.Get("/health", apiHandlers.HealthCheck)
-	})
+	// API routes are defined in the api package's route table, which also
+	// produces the OpenAPI document served at /api/openapi.json
+	r.Route("/api", apiHandlers.Mount)
 
 	// WebSocket route
 	r.Get("/ws/party/{partyID}", hub.ServeWS)
 
+	// Liveness and readiness probes
+	r.Get("/healthz", health.Liveness)
+	r.Get("/readyz", checker.Readiness)
+
+	// Prometheus metrics
+	r.Handle("/metrics", metrics.Handler())
+
 	// Create HTTP server
 	server := &http.Server{
 		Addr:    ":" + cfg.Port,
@@ -91,9 +120,9 @@ func main() {
 
 	// Start server in a goroutine
 	go func() {
-		log.Printf("Server starting on port %s", cfg.Port)
+		slog.Info("Server starting", "port", cfg.Port)
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Failed to start server: %v", err)
+			fatal("Failed to start server", err)
 		}
 	}()
 
@@ -101,15 +130,35 @@ func main() {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
-	log.Println("Shutting down server...")
+	slog.Info("Shutting down server")
 
 	// Graceful shutdown with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	// Stop the hub alongside the HTTP server: the server finishes in-flight
+	// requests but does not track hijacked WebSocket connections, which the
+	// hub closes once in-flight party operations have finished
+	stopHub()
 	if err := server.Shutdown(ctx); err != nil {
-		log.Fatalf("Server shutdown failed: %v", err)
+		fatal("Server shutdown failed", err)
 	}
+	select {
+	case <-hub.Done():
+	case <-ctx.Done():
+		slog.Warn("WebSocket hub did not shut down in time")
+	}
+
+	// Flush spans still waiting to be exported
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Error("Tracing shutdown failed", "error", err)
+	}
+
+	slog.Info("Server shutdown complete")
+}
 
-	log.Println("Server shutdown complete")
+// fatal logs an error and exits
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
 }
@@ -0,0 +1,343 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/reelchoice/backend/internal/apierror"
+	"github.com/reelchoice/backend/internal/logging"
+	"github.com/reelchoice/backend/internal/party"
+)
+
+// The handlers in this file mirror the WebSocket party actions so that
+// scripts, bots and tests can drive a party over HTTP. They call the same
+// party.Service methods and broadcast the result to connected clients.
+
+// UpdateSettings handles PUT /api/party/{id}/settings (host only)
+func (h *Handlers) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	ctx, partyID, tokenInfo, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	var req party.UpdateSettingsPayload
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Invalid request body"))
+		return
+	}
+
+	updatedParty, err := h.partyService.UpdateSettings(ctx, partyID, tokenInfo.UserID, req)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error updating settings", "error", err)
+		apierror.Write(w, err)
+		return
+	}
+
+	h.respondWithParty(w, updatedParty)
+}
+
+// SetVoterWeight handles PUT /api/party/{id}/voter-weight (host only)
+func (h *Handlers) SetVoterWeight(w http.ResponseWriter, r *http.Request) {
+	ctx, partyID, tokenInfo, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	var req party.SetVoterWeightPayload
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Invalid request body"))
+		return
+	}
+
+	updatedParty, err := h.partyService.SetVoterWeight(ctx, partyID, tokenInfo.UserID, req.UserID, req.Weight)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error setting voter weight", "error", err)
+		apierror.Write(w, err)
+		return
+	}
+
+	h.respondWithParty(w, updatedParty)
+}
+
+// SuggestMovie handles POST /api/party/{id}/suggest
+func (h *Handlers) SuggestMovie(w http.ResponseWriter, r *http.Request) {
+	ctx, partyID, tokenInfo, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	var req party.SuggestMoviePayload
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Invalid request body"))
+		return
+	}
+
+	if req.TMDBID == "" {
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("tmdb_id is required"))
+		return
+	}
+
+	updatedParty, err := h.partyService.SuggestMovie(ctx, partyID, tokenInfo.UserID, req.TMDBID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error suggesting movie", "error", err)
+		apierror.Write(w, err)
+		return
+	}
+
+	h.respondWithParty(w, updatedParty)
+}
+
+// VoteNomination handles POST /api/party/{id}/vote
+func (h *Handlers) VoteNomination(w http.ResponseWriter, r *http.Request) {
+	ctx, partyID, tokenInfo, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	var req party.VotePayload
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Invalid request body"))
+		return
+	}
+
+	updatedParty, err := h.partyService.VoteNomination(ctx, partyID, tokenInfo.UserID, req.Vote)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error voting on nomination", "error", err)
+		apierror.Write(w, err)
+		return
+	}
+
+	h.respondWithParty(w, updatedParty)
+}
+
+// RetractVote handles DELETE /api/party/{id}/vote
+func (h *Handlers) RetractVote(w http.ResponseWriter, r *http.Request) {
+	ctx, partyID, tokenInfo, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	updatedParty, err := h.partyService.RetractVote(ctx, partyID, tokenInfo.UserID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error retracting vote", "error", err)
+		apierror.Write(w, err)
+		return
+	}
+
+	h.respondWithParty(w, updatedParty)
+}
+
+// CloseVoting handles POST /api/party/{id}/close-voting (host only)
+func (h *Handlers) CloseVoting(w http.ResponseWriter, r *http.Request) {
+	ctx, partyID, tokenInfo, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	updatedParty, err := h.partyService.CloseVoting(ctx, partyID, tokenInfo.UserID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error closing voting", "error", err)
+		apierror.Write(w, err)
+		return
+	}
+
+	h.respondWithParty(w, updatedParty)
+}
+
+// VetoMovie handles POST /api/party/{id}/veto
+func (h *Handlers) VetoMovie(w http.ResponseWriter, r *http.Request) {
+	ctx, partyID, tokenInfo, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	var req party.VetoPayload
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Invalid request body"))
+		return
+	}
+
+	updatedParty, veto, err := h.partyService.VetoMovie(ctx, partyID, tokenInfo.UserID, req.MovieID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error vetoing movie", "error", err)
+		apierror.Write(w, err)
+		return
+	}
+
+	h.hub.BroadcastVeto(updatedParty, *veto)
+	h.respondWithParty(w, updatedParty)
+}
+
+// FinalizeNominations handles POST /api/party/{id}/finalize-nominations (host only)
+func (h *Handlers) FinalizeNominations(w http.ResponseWriter, r *http.Request) {
+	ctx, partyID, tokenInfo, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	updatedParty, err := h.partyService.FinalizeNominations(ctx, partyID, tokenInfo.UserID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error finalizing nominations", "error", err)
+		apierror.Write(w, err)
+		return
+	}
+
+	h.respondWithParty(w, updatedParty)
+}
+
+// SubmitApprovals handles POST /api/party/{id}/approvals
+func (h *Handlers) SubmitApprovals(w http.ResponseWriter, r *http.Request) {
+	ctx, partyID, tokenInfo, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	var req party.SubmitApprovalsPayload
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Invalid request body"))
+		return
+	}
+
+	updatedParty, err := h.partyService.SubmitApprovals(ctx, partyID, tokenInfo.UserID, req.MovieIDs)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error submitting approvals", "error", err)
+		apierror.Write(w, err)
+		return
+	}
+
+	h.respondWithParty(w, updatedParty)
+}
+
+// CloseApproval handles POST /api/party/{id}/close-approval (host only)
+func (h *Handlers) CloseApproval(w http.ResponseWriter, r *http.Request) {
+	ctx, partyID, tokenInfo, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	updatedParty, err := h.partyService.CloseApproval(ctx, partyID, tokenInfo.UserID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error closing approval round", "error", err)
+		apierror.Write(w, err)
+		return
+	}
+
+	h.respondWithParty(w, updatedParty)
+}
+
+// SubmitRanking handles POST /api/party/{id}/ranking
+func (h *Handlers) SubmitRanking(w http.ResponseWriter, r *http.Request) {
+	ctx, partyID, tokenInfo, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	var req party.SubmitRankingPayload
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Invalid request body"))
+		return
+	}
+
+	updatedParty, err := h.partyService.SubmitRanking(ctx, partyID, tokenInfo.UserID, req.Ranks, req.NoOpinion)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error submitting ranking", "error", err)
+		apierror.Write(w, err)
+		return
+	}
+
+	if updatedParty.Phase == party.PhaseFinished && updatedParty.Winner != nil {
+		logging.FromContext(ctx).Info("Party completed", "winner_id", updatedParty.Winner.ID)
+	}
+
+	h.respondWithParty(w, updatedParty)
+}
+
+// CloseRanking handles POST /api/party/{id}/close-ranking (host only)
+func (h *Handlers) CloseRanking(w http.ResponseWriter, r *http.Request) {
+	ctx, partyID, tokenInfo, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	updatedParty, err := h.partyService.CloseRanking(ctx, partyID, tokenInfo.UserID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error closing ranking", "error", err)
+		apierror.Write(w, err)
+		return
+	}
+
+	if updatedParty.Winner != nil {
+		logging.FromContext(ctx).Info("Party completed", "winner_id", updatedParty.Winner.ID)
+	}
+
+	h.respondWithParty(w, updatedParty)
+}
+
+// VoteRunoff handles POST /api/party/{id}/runoff/vote
+func (h *Handlers) VoteRunoff(w http.ResponseWriter, r *http.Request) {
+	ctx, partyID, tokenInfo, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	var req party.RunoffVotePayload
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Invalid request body"))
+		return
+	}
+
+	updatedParty, err := h.partyService.VoteRunoff(ctx, partyID, tokenInfo.UserID, req.MovieID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error voting in runoff", "error", err)
+		apierror.Write(w, err)
+		return
+	}
+
+	h.respondWithParty(w, updatedParty)
+}
+
+// DecideRunoff handles POST /api/party/{id}/runoff/decide (host only)
+func (h *Handlers) DecideRunoff(w http.ResponseWriter, r *http.Request) {
+	ctx, partyID, tokenInfo, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	var req party.RunoffVotePayload
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Invalid request body"))
+		return
+	}
+
+	updatedParty, err := h.partyService.DecideRunoff(ctx, partyID, tokenInfo.UserID, req.MovieID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error deciding runoff", "error", err)
+		apierror.Write(w, err)
+		return
+	}
+
+	h.respondWithParty(w, updatedParty)
+}
+
+// Rematch handles POST /api/party/{id}/rematch (host only)
+func (h *Handlers) Rematch(w http.ResponseWriter, r *http.Request) {
+	ctx, partyID, tokenInfo, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	var req party.RematchPayload
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Invalid request body"))
+		return
+	}
+
+	updatedParty, err := h.partyService.Rematch(ctx, partyID, tokenInfo.UserID, req.Phase, req.CarryOverPool)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error starting rematch", "error", err)
+		apierror.Write(w, err)
+		return
+	}
+
+	h.respondWithParty(w, updatedParty)
+}
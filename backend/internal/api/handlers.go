@@ -3,18 +3,25 @@ package api
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"log/slog"
 	"net/http"
+	"os"
+	"strconv"
 	"time"
 
+	"github.com/reelchoice/backend/internal/apierror"
+	"github.com/reelchoice/backend/internal/catalog"
 	"github.com/reelchoice/backend/internal/config"
 	"github.com/reelchoice/backend/internal/database"
+	"github.com/reelchoice/backend/internal/health"
+	"github.com/reelchoice/backend/internal/logging"
+	"github.com/reelchoice/backend/internal/metadata"
+	"github.com/reelchoice/backend/internal/omdb"
 	"github.com/reelchoice/backend/internal/party"
 	"github.com/reelchoice/backend/internal/tmdb"
 	"github.com/reelchoice/backend/internal/websocket"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/google/uuid"
 )
 
 // Handlers contains all the HTTP handlers and their dependencies
@@ -22,15 +29,20 @@ type Handlers struct {
 	redis        *database.RedisClient
 	hub          *websocket.Hub
 	config       *config.Config
-	tmdbClient   *tmdb.Client
+	tmdbClient   party.TMDBClient
 	tokenManager *party.TokenManager
 	partyService *party.Service
 }
 
-// NewHandlers creates a new Handlers instance with dependencies
-func NewHandlers(redis *database.RedisClient, hub *websocket.Hub, cfg *config.Config) *Handlers {
-	// Create TMDB client
-	tmdbClient := tmdb.NewClient(cfg.TMDBApiKey, redis)
+// NewHandlers creates a new Handlers instance with dependencies. Metadata
+// providers that support it are added to checker's readiness checks.
+func NewHandlers(redis *database.RedisClient, hub *websocket.Hub, cfg *config.Config, checker *health.Checker) *Handlers {
+	// Create the metadata provider chain (TMDB, OMDb and/or a local catalog)
+	tmdbClient, err := newMetadataProvider(cfg, redis, checker)
+	if err != nil {
+		slog.Error("Failed to set up metadata providers", "error", err)
+		os.Exit(1)
+	}
 
 	// Create token manager with Redis backend
 	tokenManager := party.NewTokenManager(redis)
@@ -53,66 +65,82 @@ func NewHandlers(redis *database.RedisClient, hub *websocket.Hub, cfg *config.Co
 	}
 }
 
+// newMetadataProvider registers the configured metadata providers and
+// combines them into a single provider that falls back in configured order
+func newMetadataProvider(cfg *config.Config, redis *database.RedisClient, checker *health.Checker) (party.TMDBClient, error) {
+	registry := metadata.NewRegistry()
+
+	for _, name := range cfg.MetadataProviders {
+		switch name {
+		case "tmdb":
+			tmdbClient := tmdb.NewClient(cfg.TMDBApiKey, redis)
+			registry.Register(name, tmdbClient)
+			// Other providers can serve searches while TMDB is down, so
+			// TMDB only degrades readiness
+			if cfg.ReadinessCheckTMDB {
+				checker.Add(name, tmdbClient.Ping, false)
+			}
+		case "omdb":
+			registry.Register(name, omdb.NewClient(cfg.OMDbApiKey, redis))
+		case "local":
+			localCatalog, err := catalog.Load(cfg.CatalogPath)
+			if err != nil {
+				return nil, err
+			}
+			registry.Register(name, localCatalog)
+		}
+	}
+
+	return registry.Composite(cfg.MetadataProviders...)
+}
+
 // CreateParty handles POST /api/party
 func (h *Handlers) CreateParty(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		Name string `json:"name"`
-	}
+	var req CreatePartyRequest
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Invalid request body"))
 		return
 	}
 
 	if req.Name == "" {
-		http.Error(w, "Party name cannot be empty", http.StatusBadRequest)
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Party name cannot be empty"))
 		return
 	}
 
-	// Generate party ID
-	partyID := uuid.New().String()
-
-	// Generate host ID
-	hostID := uuid.New().String()
-
-	// Create new party
-	newParty := &party.Party{
-		ID:           partyID,
-		Name:         req.Name,
-		Participants: make(map[string]*party.Participant),
-		Phase:        party.PhaseLobby,
-		CreatedAt:    time.Now(),
+	settings := party.DefaultSettings()
+	if req.Settings != nil {
+		settings = *req.Settings
 	}
 
-	// Add creator as host
-	newParty.AddParticipant(hostID, "Host", true)
-
-	// Save to Redis
-	ctx := context.Background()
-	if err := h.redis.SaveParty(ctx, newParty); err != nil {
-		log.Printf("Error saving party to Redis: %v", err)
-		http.Error(w, "Failed to create party", http.StatusInternalServerError)
+	// Create the party with the creator as host
+	ctx := r.Context()
+	newParty, host, err := h.partyService.CreateParty(ctx, req.Name, settings)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error creating party", "error", err)
+		apierror.Write(w, err)
 		return
 	}
 
-	log.Printf("Party created: %s (ID: %s)", newParty.Name, newParty.ID)
+	ctx = logging.With(ctx, logging.KeyPartyID, newParty.ID, logging.KeyUserID, host.ID)
+	logging.FromContext(ctx).Info("Party created")
 
 	// Create authentication token for the host
-	authToken, err := h.tokenManager.CreateToken(ctx, partyID, hostID, "Host", true)
+	authToken, err := h.tokenManager.CreateToken(ctx, newParty.ID, host.ID, host.Username, true)
 	if err != nil {
-		log.Printf("Error creating host auth token: %v", err)
-		http.Error(w, "Failed to create authentication token", http.StatusInternalServerError)
+		logging.FromContext(ctx).Error("Error creating host auth token", "error", err)
+		apierror.Write(w, party.ErrInternal.WithMessage("Failed to create authentication token"))
 		return
 	}
 
 	// Return party info with auth token
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"party_id":   partyID,
-		"host_id":    hostID,
-		"party":      newParty,
-		"auth_token": authToken.Token,
+	json.NewEncoder(w).Encode(CreatePartyResponse{
+		PartyID:   newParty.ID,
+		HostID:    host.ID,
+		Party:     newParty.View(),
+		AuthToken: authToken.Token,
 	})
 }
 
@@ -120,234 +148,193 @@ func (h *Handlers) CreateParty(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) GetParty(w http.ResponseWriter, r *http.Request) {
 	partyID := chi.URLParam(r, "id")
 	if partyID == "" {
-		http.Error(w, "Party ID is required", http.StatusBadRequest)
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Party ID is required"))
 		return
 	}
 
-	ctx := context.Background()
-	party, err := h.redis.GetParty(ctx, partyID)
+	ctx := logging.With(r.Context(), logging.KeyPartyID, partyID)
+	partyData, err := h.redis.GetParty(ctx, partyID)
 	if err != nil {
-		log.Printf("Error getting party %s: %v", partyID, err)
-		http.Error(w, "Failed to get party", http.StatusInternalServerError)
+		logging.FromContext(ctx).Error("Error getting party", "error", err)
+		apierror.Write(w, party.ErrInternal.WithMessage("Failed to get party"))
 		return
 	}
 
-	if party == nil {
-		http.Error(w, "Party not found", http.StatusNotFound)
+	if partyData == nil {
+		apierror.Write(w, party.ErrPartyNotFound)
 		return
 	}
 
+	// Apply a deadline that has passed without any instance resolving it
+	if deadline, ok := partyData.NextDeadline(); ok && !time.Now().Before(deadline) {
+		updatedParty, err := h.partyService.ResolveDeadlines(ctx, partyID)
+		if err != nil {
+			logging.FromContext(ctx).Warn("Error resolving deadline", "error", err)
+		} else if updatedParty != nil {
+			h.hub.BroadcastParty(updatedParty)
+			partyData = updatedParty
+		}
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(party)
+	json.NewEncoder(w).Encode(partyData.View())
 }
 
 // JoinParty handles POST /api/party/{id}/join
 func (h *Handlers) JoinParty(w http.ResponseWriter, r *http.Request) {
 	partyID := chi.URLParam(r, "id")
 	if partyID == "" {
-		http.Error(w, "Party ID is required", http.StatusBadRequest)
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Party ID is required"))
 		return
 	}
 
-	var req struct {
-		Username string `json:"username"`
-	}
+	var req JoinPartyRequest
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
-		return
-	}
-
-	if req.Username == "" {
-		http.Error(w, "Username cannot be empty", http.StatusBadRequest)
-		return
-	}
-
-	ctx := context.Background()
-	party, err := h.redis.GetParty(ctx, partyID)
-	if err != nil {
-		log.Printf("Error getting party %s: %v", partyID, err)
-		http.Error(w, "Failed to get party", http.StatusInternalServerError)
-		return
-	}
-
-	if party == nil {
-		http.Error(w, "Party not found", http.StatusNotFound)
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Invalid request body"))
 		return
 	}
 
-	// Check if username is already taken
-	for _, participant := range party.Participants {
-		if participant.Username == req.Username {
-			http.Error(w, "Username already taken in this party", http.StatusConflict)
-			return
-		}
-	}
-
-	// Generate user ID
-	userID := uuid.New().String()
-
 	// Add participant to party
-	party.AddParticipant(userID, req.Username, false)
-
-	// Save updated party
-	if err := h.redis.SaveParty(ctx, party); err != nil {
-		log.Printf("Error saving party after join: %v", err)
-		http.Error(w, "Failed to join party", http.StatusInternalServerError)
+	ctx := logging.With(r.Context(), logging.KeyPartyID, partyID)
+	partyData, participant, err := h.partyService.JoinParty(ctx, partyID, req.Username)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error joining party", "error", err)
+		apierror.Write(w, err)
 		return
 	}
 
-	log.Printf("User %s (%s) joined party %s", req.Username, userID, partyID)
+	ctx = logging.With(ctx, logging.KeyUserID, participant.ID)
+	logging.FromContext(ctx).Info("User joined party")
 
 	// Create authentication token
-	authToken, err := h.tokenManager.CreateToken(ctx, partyID, userID, req.Username, false)
+	authToken, err := h.tokenManager.CreateToken(ctx, partyID, participant.ID, participant.Username, false)
 	if err != nil {
-		log.Printf("Error creating auth token: %v", err)
-		http.Error(w, "Failed to create authentication token", http.StatusInternalServerError)
+		logging.FromContext(ctx).Error("Error creating auth token", "error", err)
+		apierror.Write(w, party.ErrInternal.WithMessage("Failed to create authentication token"))
 		return
 	}
 
 	// Broadcast party update to all connected clients
-	h.broadcastPartyUpdate(party)
+	h.hub.BroadcastParty(partyData)
 
 	// Return response with auth token
-	participant := party.GetParticipant(userID)
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"user_id":     userID,
-		"participant": participant,
-		"party":       party,
-		"auth_token":  authToken.Token,
+	json.NewEncoder(w).Encode(JoinPartyResponse{
+		UserID:      participant.ID,
+		Participant: participant,
+		Party:       partyData.View(),
+		AuthToken:   authToken.Token,
 	})
 }
 
 // StartNomination handles POST /api/party/{id}/start-nomination (host only)
 func (h *Handlers) StartNomination(w http.ResponseWriter, r *http.Request) {
-	partyID := chi.URLParam(r, "id")
-	if partyID == "" {
-		http.Error(w, "Party ID is required", http.StatusBadRequest)
-		return
-	}
-
-	// Extract and validate auth token
-	authToken := h.extractAuthToken(r)
-	if authToken == "" {
-		http.Error(w, "Authorization token required", http.StatusUnauthorized)
+	ctx, partyID, tokenInfo, ok := h.authenticate(w, r)
+	if !ok {
 		return
 	}
 
-	ctx := context.Background()
-	tokenInfo, err := h.tokenManager.ValidateToken(ctx, authToken)
+	updatedParty, err := h.partyService.StartNomination(ctx, partyID, tokenInfo.UserID)
 	if err != nil {
-		http.Error(w, "Invalid or expired token", http.StatusUnauthorized)
+		logging.FromContext(ctx).Warn("Error starting nomination", "error", err)
+		apierror.Write(w, err)
 		return
 	}
 
-	// Verify token is for this party
-	if tokenInfo.PartyID != partyID {
-		http.Error(w, "Token not valid for this party", http.StatusForbidden)
-		return
-	}
-
-	// Verify user is host
-	if !tokenInfo.IsHost {
-		http.Error(w, "Only the host can start nomination phase", http.StatusForbidden)
-		return
-	}
-	partyData, err := h.redis.GetParty(ctx, partyID)
-	if err != nil {
-		log.Printf("Error getting party %s: %v", partyID, err)
-		http.Error(w, "Failed to get party", http.StatusInternalServerError)
-		return
-	}
-
-	if partyData == nil {
-		http.Error(w, "Party not found", http.StatusNotFound)
-		return
-	}
-
-	// User is already verified as host by token validation above
+	logging.FromContext(ctx).Info("Nomination phase started")
+	h.respondWithParty(w, updatedParty)
+}
 
-	// Check if party is in lobby phase
-	if partyData.Phase != party.PhaseLobby {
-		http.Error(w, "Party must be in lobby phase to start nominations", http.StatusBadRequest)
+// SearchMovies handles GET /api/movies/search
+func (h *Handlers) SearchMovies(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("q")
+	if query == "" {
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Search query is required"))
 		return
 	}
 
-	// Change phase to nominating
-	partyData.Phase = party.PhaseNominating
-
-	// Initialize nomination fields
-	partyData.CurrentNomination = nil
-	partyData.NominationPool = make([]party.Movie, 0)
-
-	// Save updated party
-	if err := h.redis.SaveParty(ctx, partyData); err != nil {
-		log.Printf("Error saving party after starting nomination: %v", err)
-		http.Error(w, "Failed to start nomination phase", http.StatusInternalServerError)
+	opts, err := parseSearchOptions(r)
+	if err != nil {
+		apierror.Write(w, err)
 		return
 	}
 
-	log.Printf("Nomination phase started for party %s", partyID)
-
-	// Broadcast party update
-	h.broadcastPartyUpdate(partyData)
-
-	// Return updated party
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(partyData)
-}
-
-// SearchMovies handles GET /api/movies/search
-func (h *Handlers) SearchMovies(w http.ResponseWriter, r *http.Request) {
-	query := r.URL.Query().Get("q")
-	if query == "" {
-		http.Error(w, "Search query is required", http.StatusBadRequest)
+	if err := opts.Validate(); err != nil {
+		apierror.Write(w, err)
 		return
 	}
 
-	ctx := context.Background()
-	movies, err := h.tmdbClient.SearchMovies(ctx, query)
+	ctx := r.Context()
+	result, err := h.tmdbClient.SearchMovies(ctx, query, opts)
 	if err != nil {
-		log.Printf("Error searching movies: %v", err)
-		http.Error(w, "Movie search failed", http.StatusInternalServerError)
+		logging.FromContext(ctx).Warn("Error searching movies", "error", err)
+		apierror.Write(w, err)
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"query":  query,
-		"movies": movies,
+	json.NewEncoder(w).Encode(SearchMoviesResponse{
+		Query:        query,
+		Movies:       result.Movies,
+		Page:         result.Page,
+		TotalResults: result.TotalResults,
+		TotalPages:   result.TotalPages,
 	})
 }
 
-// HealthCheck handles GET /api/health
+// HealthCheck handles GET /api/health. Deprecated in favor of GET /healthz
+// and GET /readyz; it does not check dependencies.
 func (h *Handlers) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"status":    "ok",
-		"message":   "ReelChoice backend is running",
-		"timestamp": time.Now().Unix(),
+	json.NewEncoder(w).Encode(HealthResponse{
+		Status:    "ok",
+		Message:   "ReelChoice backend is running",
+		Timestamp: time.Now().Unix(),
 	})
 }
 
-// broadcastPartyUpdate sends party updates to all connected WebSocket clients
-func (h *Handlers) broadcastPartyUpdate(partyData *party.Party) {
-	payload := party.PartyUpdatePayload{Party: partyData}
-	msg, err := party.CreateMessage(party.MessageTypePartyUpdate, payload)
-	if err != nil {
-		log.Printf("Error creating party update message: %v", err)
-		return
+// authenticate validates the bearer token for the party in the URL and
+// returns the request context, logging with the party and user IDs. On
+// failure it writes an error response and returns false.
+func (h *Handlers) authenticate(w http.ResponseWriter, r *http.Request) (context.Context, string, *party.AuthToken, bool) {
+	partyID := chi.URLParam(r, "id")
+	if partyID == "" {
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Party ID is required"))
+		return nil, "", nil, false
+	}
+
+	// Extract and validate auth token
+	authToken := h.extractAuthToken(r)
+	if authToken == "" {
+		apierror.Write(w, party.ErrUnauthorized.WithMessage("Authorization token required"))
+		return nil, "", nil, false
 	}
 
-	data, err := json.Marshal(msg)
+	ctx := r.Context()
+	tokenInfo, err := h.tokenManager.ValidateToken(ctx, authToken)
 	if err != nil {
-		log.Printf("Error marshaling party update: %v", err)
-		return
+		apierror.Write(w, party.ErrUnauthorized.WithMessage("Invalid or expired token"))
+		return nil, "", nil, false
+	}
+
+	// Verify token is for this party
+	if tokenInfo.PartyID != partyID {
+		apierror.Write(w, party.ErrForbidden.WithMessage("Token not valid for this party"))
+		return nil, "", nil, false
 	}
 
-	h.hub.Broadcast(partyData.ID, data)
+	ctx = logging.With(ctx, logging.KeyPartyID, partyID, logging.KeyUserID, tokenInfo.UserID)
+	return ctx, partyID, tokenInfo, true
+}
+
+// respondWithParty broadcasts the updated party to connected clients and returns it
+func (h *Handlers) respondWithParty(w http.ResponseWriter, partyData *party.Party) {
+	h.hub.BroadcastParty(partyData)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(partyData.View())
 }
 
 // extractAuthToken extracts the bearer token from the Authorization header
@@ -365,3 +352,38 @@ func (h *Handlers) extractAuthToken(r *http.Request) string {
 
 	return authHeader[len(bearerPrefix):]
 }
+
+// parseSearchOptions reads the optional search filters from the query string
+func parseSearchOptions(r *http.Request) (party.SearchOptions, error) {
+	q := r.URL.Query()
+	opts := party.SearchOptions{
+		MediaType: q.Get("media_type"),
+		Language:  q.Get("language"),
+		Region:    q.Get("region"),
+	}
+
+	intParams := map[string]*int{
+		"page":                 &opts.Page,
+		"year":                 &opts.Year,
+		"primary_release_year": &opts.PrimaryReleaseYear,
+	}
+	for name, dest := range intParams {
+		if value := q.Get(name); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil {
+				return opts, party.ErrInvalidRequest.WithMessage("%s must be a number", name)
+			}
+			*dest = parsed
+		}
+	}
+
+	if value := q.Get("include_adult"); value != "" {
+		includeAdult, err := strconv.ParseBool(value)
+		if err != nil {
+			return opts, party.ErrInvalidRequest.WithMessage("include_adult must be true or false")
+		}
+		opts.IncludeAdult = includeAdult
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,310 @@
+package api
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OpenAPIDocument is the root of an OpenAPI 3 document
+type OpenAPIDocument struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       OpenAPIInfo                      `json:"info"`
+	Servers    []OpenAPIServer                  `json:"servers"`
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components OpenAPIComponents                `json:"components"`
+}
+
+// OpenAPIInfo describes the API
+type OpenAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// OpenAPIServer is a base URL the API is served from
+type OpenAPIServer struct {
+	URL string `json:"url"`
+}
+
+// OpenAPIComponents holds reusable schemas and security schemes
+type OpenAPIComponents struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes"`
+}
+
+// SecurityScheme describes how requests authenticate
+type SecurityScheme struct {
+	Type   string `json:"type"`
+	Scheme string `json:"scheme"`
+}
+
+// Operation is a single endpoint in the OpenAPI document
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *Body                 `json:"requestBody,omitempty"`
+	Responses   map[string]*Body      `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+	Deprecated  bool                  `json:"deprecated,omitempty"`
+}
+
+// Parameter is a path or query parameter
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+// Body is a request or response body
+type Body struct {
+	Description string                `json:"description,omitempty"`
+	Required    bool                  `json:"required,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema of a body for one content type
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Schema is a JSON schema
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+}
+
+// OpenAPI handles GET /api/openapi.json
+func (h *Handlers) OpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(BuildOpenAPI())
+}
+
+// BuildOpenAPI builds the OpenAPI document from the route table and the
+// request and response types it references
+func BuildOpenAPI() *OpenAPIDocument {
+	doc := &OpenAPIDocument{
+		OpenAPI: "3.0.3",
+		Info:    OpenAPIInfo{Title: "ReelChoice API", Version: "1.0.0"},
+		Servers: []OpenAPIServer{{URL: "/api"}},
+		Paths:   make(map[string]map[string]*Operation),
+		Components: OpenAPIComponents{
+			Schemas: make(map[string]*Schema),
+			SecuritySchemes: map[string]*SecurityScheme{
+				"bearerAuth": {Type: "http", Scheme: "bearer"},
+			},
+		},
+	}
+	gen := &schemaGenerator{schemas: doc.Components.Schemas, responseTypes: make(map[reflect.Type]bool)}
+	errorSchema := gen.schemaFor(reflect.TypeOf(ErrorResponse{}))
+
+	// Handlers are never invoked, so a zero Handlers is enough to read the table
+	routes := (&Handlers{}).Routes()
+	for _, route := range routes {
+		op := &Operation{
+			OperationID: route.OperationID,
+			Summary:     route.Summary,
+			Responses:   make(map[string]*Body),
+			Deprecated:  route.Deprecated,
+		}
+		if route.Tag != "" {
+			op.Tags = []string{route.Tag}
+		}
+
+		for _, name := range pathParams(route.Path) {
+			op.Parameters = append(op.Parameters, Parameter{
+				Name: name, In: "path", Required: true, Schema: &Schema{Type: "string"},
+			})
+		}
+
+		if route.Query != nil {
+			query := gen.inlineSchema(reflect.TypeOf(route.Query))
+			for _, name := range slices.Sorted(maps.Keys(query.Properties)) {
+				op.Parameters = append(op.Parameters, Parameter{
+					Name: name, In: "query", Required: slices.Contains(query.Required, name), Schema: query.Properties[name],
+				})
+			}
+		}
+
+		responseSchema := &Schema{Type: "object"}
+		if route.Response != nil {
+			responseSchema = gen.schemaFor(reflect.TypeOf(route.Response))
+		}
+		op.Responses[statusKey(route.Status)] = &Body{
+			Description: http.StatusText(route.Status),
+			Content:     jsonContent(responseSchema),
+		}
+		op.Responses["default"] = &Body{
+			Description: "Error",
+			Content:     jsonContent(errorSchema),
+		}
+
+		if route.Auth {
+			op.Security = []map[string][]string{{"bearerAuth": {}}}
+		}
+
+		if doc.Paths[route.Path] == nil {
+			doc.Paths[route.Path] = make(map[string]*Operation)
+		}
+		doc.Paths[route.Path][strings.ToLower(route.Method)] = op
+	}
+
+	// Request bodies come last so that every type shared with a response is
+	// known by the time it is used as input
+	requests := &schemaGenerator{schemas: doc.Components.Schemas, responseTypes: gen.responseTypes, request: true}
+	for _, route := range routes {
+		if route.Request != nil {
+			doc.Paths[route.Path][strings.ToLower(route.Method)].RequestBody = &Body{
+				Required: true,
+				Content:  jsonContent(requests.schemaFor(reflect.TypeOf(route.Request))),
+			}
+		}
+	}
+
+	return doc
+}
+
+// schemaGenerator converts Go types to JSON schemas, registering named
+// structs as components
+type schemaGenerator struct {
+	schemas       map[string]*Schema
+	responseTypes map[reflect.Type]bool // Named structs registered for responses
+	request       bool                  // Generating request bodies
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemaFor returns a schema for t, referencing a component for named structs
+func (g *schemaGenerator) schemaFor(t reflect.Type) *Schema {
+	switch t.Kind() {
+	case reflect.Ptr:
+		schema := g.schemaFor(t.Elem())
+		if schema.Ref != "" {
+			return schema
+		}
+		schema.Nullable = true
+		return schema
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: g.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaFor(t.Elem())}
+	case reflect.Interface:
+		return &Schema{}
+	case reflect.Struct:
+		if t == timeType {
+			return &Schema{Type: "string", Format: "date-time"}
+		}
+		if t.Name() == "" {
+			return g.inlineSchema(t)
+		}
+		if g.request && g.responseTypes[t] {
+			return g.inputSchemaFor(t)
+		}
+		if _, exists := g.schemas[t.Name()]; !exists {
+			g.schemas[t.Name()] = &Schema{} // Placeholder guards against recursive types
+			g.schemas[t.Name()] = g.inlineSchema(t)
+			if !g.request {
+				g.responseTypes[t] = true
+			}
+		}
+		return &Schema{Ref: "#/components/schemas/" + t.Name()}
+	default:
+		return &Schema{}
+	}
+}
+
+// inputSchemaFor returns a reference to the input component of a struct that
+// is also used in responses, such as PartySettings. Responses always include
+// every field, but handlers fill fields missing from a request with their
+// defaults, so none of the input fields are required.
+func (g *schemaGenerator) inputSchemaFor(t reflect.Type) *Schema {
+	name := t.Name() + "Input"
+	if _, exists := g.schemas[name]; !exists {
+		g.schemas[name] = &Schema{} // Placeholder guards against recursive types
+		schema := g.inlineSchema(t)
+		schema.Required = nil
+		g.schemas[name] = schema
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// inlineSchema returns the object schema of a struct, flattening embedded
+// structs the way encoding/json does. Fields without omitempty are required.
+func (g *schemaGenerator) inlineSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" || (!field.IsExported() && !field.Anonymous) {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			embedded := g.inlineSchema(field.Type)
+			for propName, prop := range embedded.Properties {
+				schema.Properties[propName] = prop
+			}
+			schema.Required = append(schema.Required, embedded.Required...)
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		schema.Properties[name] = g.schemaFor(field.Type)
+		if !strings.Contains(options, "omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+
+	return schema
+}
+
+// pathParams returns the names of the {param} segments of a route path
+func pathParams(path string) []string {
+	var params []string
+	for _, segment := range strings.Split(path, "/") {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params = append(params, strings.Trim(segment, "{}"))
+		}
+	}
+	return params
+}
+
+// jsonContent wraps a schema as an application/json body
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{"application/json": {Schema: schema}}
+}
+
+// statusKey formats a status code as an OpenAPI response key
+func statusKey(status int) string {
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return strconv.Itoa(status)
+}
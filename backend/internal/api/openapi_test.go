@@ -0,0 +1,50 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+// TestOpenAPIDocumentUpToDate checks that the checked-in openapi.json matches
+// the document built from the route table
+func TestOpenAPIDocumentUpToDate(t *testing.T) {
+	var built bytes.Buffer
+	encoder := json.NewEncoder(&built)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(BuildOpenAPI()); err != nil {
+		t.Fatalf("encoding OpenAPI document: %v", err)
+	}
+
+	checkedIn, err := os.ReadFile("../../openapi.json")
+	if err != nil {
+		t.Fatalf("reading openapi.json: %v", err)
+	}
+
+	if !bytes.Equal(built.Bytes(), checkedIn) {
+		t.Error("openapi.json is out of date with the route table; run `go generate ./client` from the backend directory to regenerate it and the Go client")
+	}
+}
+
+// TestRequestBodiesRequireOnlyRejectedFields checks that request fields the
+// handlers fill with defaults are optional, while responses list every field
+func TestRequestBodiesRequireOnlyRejectedFields(t *testing.T) {
+	doc := BuildOpenAPI()
+
+	settingsRef := doc.Paths["/party/{id}/settings"]["put"].RequestBody.Content["application/json"].Schema.Ref
+	if settingsRef != "#/components/schemas/PartySettingsInput" {
+		t.Fatalf("settings request body = %q, want PartySettingsInput", settingsRef)
+	}
+	if required := doc.Components.Schemas["PartySettingsInput"].Required; len(required) != 0 {
+		t.Errorf("PartySettingsInput requires %v, want no required fields", required)
+	}
+	if required := doc.Components.Schemas["PartySettings"].Required; len(required) == 0 {
+		t.Error("PartySettings has no required fields, want every field required in responses")
+	}
+
+	join := doc.Components.Schemas["JoinPartyRequest"]
+	if len(join.Required) != 1 || join.Required[0] != "username" {
+		t.Errorf("JoinPartyRequest requires %v, want [username]", join.Required)
+	}
+}
@@ -0,0 +1,171 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/reelchoice/backend/internal/party"
+)
+
+// Route describes a REST endpoint. The route table drives both the router
+// and the OpenAPI document, so the published contract cannot drift from the
+// endpoints actually served.
+type Route struct {
+	Method      string
+	Path        string // Relative to /api, in chi pattern syntax
+	OperationID string
+	Summary     string
+	Tag         string
+	Auth        bool        // Requires an "Authorization: Bearer" token
+	Query       interface{} // Struct whose JSON fields are the query parameters, or nil
+	Request     interface{} // Request body type, or nil
+	Response    interface{} // Success response body type, or nil for a free-form object
+	Status      int         // Success status code
+	Deprecated  bool        // Kept for compatibility; clients should move off it
+	Handler     http.HandlerFunc
+}
+
+// Routes returns the REST API route table
+func (h *Handlers) Routes() []Route {
+	return []Route{
+		{
+			Method: http.MethodPost, Path: "/party", OperationID: "createParty", Tag: "party",
+			Summary: "Create a new party",
+			Request: CreatePartyRequest{}, Response: CreatePartyResponse{}, Status: http.StatusCreated,
+			Handler: h.CreateParty,
+		},
+		{
+			Method: http.MethodGet, Path: "/party/{id}", OperationID: "getParty", Tag: "party",
+			Summary:  "Get party information",
+			Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.GetParty,
+		},
+		{
+			Method: http.MethodPost, Path: "/party/{id}/join", OperationID: "joinParty", Tag: "party",
+			Summary: "Join a party",
+			Request: JoinPartyRequest{}, Response: JoinPartyResponse{}, Status: http.StatusCreated,
+			Handler: h.JoinParty,
+		},
+		{
+			Method: http.MethodPut, Path: "/party/{id}/settings", OperationID: "updateSettings", Tag: "party",
+			Summary: "Replace the party settings while in the lobby (host only)", Auth: true,
+			Request: party.UpdateSettingsPayload{}, Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.UpdateSettings,
+		},
+		{
+			Method: http.MethodPut, Path: "/party/{id}/voter-weight", OperationID: "setVoterWeight", Tag: "party",
+			Summary: "Set how much a participant's votes count while in the lobby (host only)", Auth: true,
+			Request: party.SetVoterWeightPayload{}, Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.SetVoterWeight,
+		},
+		{
+			Method: http.MethodPost, Path: "/party/{id}/start-nomination", OperationID: "startNomination", Tag: "party",
+			Summary: "Start the nomination phase (host only)", Auth: true,
+			Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.StartNomination,
+		},
+		{
+			Method: http.MethodPost, Path: "/party/{id}/suggest", OperationID: "suggestMovie", Tag: "party",
+			Summary: "Suggest a movie for nomination", Auth: true,
+			Request: party.SuggestMoviePayload{}, Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.SuggestMovie,
+		},
+		{
+			Method: http.MethodPost, Path: "/party/{id}/vote", OperationID: "voteNomination", Tag: "party",
+			Summary: "Vote on the current nomination", Auth: true,
+			Request: party.VotePayload{}, Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.VoteNomination,
+		},
+		{
+			Method: http.MethodDelete, Path: "/party/{id}/vote", OperationID: "retractVote", Tag: "party",
+			Summary: "Retract your vote on the current nomination", Auth: true,
+			Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.RetractVote,
+		},
+		{
+			Method: http.MethodPost, Path: "/party/{id}/close-voting", OperationID: "closeVoting", Tag: "party",
+			Summary: "Close voting on the current nomination (host only)", Auth: true,
+			Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.CloseVoting,
+		},
+		{
+			Method: http.MethodPost, Path: "/party/{id}/veto", OperationID: "vetoMovie", Tag: "party",
+			Summary: "Use a veto to block or remove a nominated movie", Auth: true,
+			Request: party.VetoPayload{}, Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.VetoMovie,
+		},
+		{
+			Method: http.MethodPost, Path: "/party/{id}/finalize-nominations", OperationID: "finalizeNominations", Tag: "party",
+			Summary: "End the nomination phase (host only)", Auth: true,
+			Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.FinalizeNominations,
+		},
+		{
+			Method: http.MethodPost, Path: "/party/{id}/approvals", OperationID: "submitApprovals", Tag: "party",
+			Summary: "Submit approvals in the approval round", Auth: true,
+			Request: party.SubmitApprovalsPayload{}, Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.SubmitApprovals,
+		},
+		{
+			Method: http.MethodPost, Path: "/party/{id}/close-approval", OperationID: "closeApproval", Tag: "party",
+			Summary: "End the approval round with the approvals received (host only)", Auth: true,
+			Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.CloseApproval,
+		},
+		{
+			Method: http.MethodPost, Path: "/party/{id}/ranking", OperationID: "submitRanking", Tag: "party",
+			Summary: "Submit ranked preferences", Auth: true,
+			Request: party.SubmitRankingPayload{}, Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.SubmitRanking,
+		},
+		{
+			Method: http.MethodPost, Path: "/party/{id}/close-ranking", OperationID: "closeRanking", Tag: "party",
+			Summary: "End the ranking phase with the ballots received (host only)", Auth: true,
+			Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.CloseRanking,
+		},
+		{
+			Method: http.MethodPost, Path: "/party/{id}/runoff/vote", OperationID: "voteRunoff", Tag: "party",
+			Summary: "Vote in the runoff between tied movies", Auth: true,
+			Request: party.RunoffVotePayload{}, Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.VoteRunoff,
+		},
+		{
+			Method: http.MethodPost, Path: "/party/{id}/runoff/decide", OperationID: "decideRunoff", Tag: "party",
+			Summary: "Pick the winner of the runoff (host only)", Auth: true,
+			Request: party.RunoffVotePayload{}, Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.DecideRunoff,
+		},
+		{
+			Method: http.MethodPost, Path: "/party/{id}/rematch", OperationID: "rematch", Tag: "party",
+			Summary: "Start a new round from a finished party (host only)", Auth: true,
+			Request: party.RematchPayload{}, Response: party.Party{}, Status: http.StatusOK,
+			Handler: h.Rematch,
+		},
+		{
+			Method: http.MethodGet, Path: "/movies/search", OperationID: "searchMovies", Tag: "movies",
+			Summary: "Search movies and TV via the metadata providers",
+			Query:   SearchMoviesQuery{}, Response: SearchMoviesResponse{}, Status: http.StatusOK,
+			Handler: h.SearchMovies,
+		},
+		{
+			Method: http.MethodGet, Path: "/health", OperationID: "healthCheck", Tag: "system",
+			Summary:  "Health check for the service; use GET /healthz and GET /readyz instead",
+			Response: HealthResponse{}, Status: http.StatusOK, Deprecated: true,
+			Handler: h.HealthCheck,
+		},
+		{
+			Method: http.MethodGet, Path: "/openapi.json", OperationID: "getOpenAPI", Tag: "system",
+			Summary: "OpenAPI document for this API",
+			Status:  http.StatusOK,
+			Handler: h.OpenAPI,
+		},
+	}
+}
+
+// Mount registers the route table on the given router
+func (h *Handlers) Mount(r chi.Router) {
+	for _, route := range h.Routes() {
+		r.Method(route.Method, route.Path, route.Handler)
+	}
+}
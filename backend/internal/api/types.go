@@ -0,0 +1,53 @@
+package api
+
+import "github.com/reelchoice/backend/internal/party"
+
+// Request and response bodies for the REST API. Party actions reuse the
+// WebSocket payload types from the party package so that both transports
+// share one contract.
+
+// CreatePartyRequest is the body of POST /api/party
+type CreatePartyRequest struct {
+	Name     string               `json:"name"`
+	Settings *party.PartySettings `json:"settings,omitempty"` // Defaults are used if omitted
+}
+
+// CreatePartyResponse is returned by POST /api/party
+type CreatePartyResponse struct {
+	PartyID   string       `json:"party_id"`
+	HostID    string       `json:"host_id"`
+	Party     *party.Party `json:"party"`
+	AuthToken string       `json:"auth_token"` // Bearer token for host-only endpoints and the WebSocket
+}
+
+// JoinPartyRequest is the body of POST /api/party/{id}/join
+type JoinPartyRequest struct {
+	Username string `json:"username"`
+}
+
+// JoinPartyResponse is returned by POST /api/party/{id}/join
+type JoinPartyResponse struct {
+	UserID      string             `json:"user_id"`
+	Participant *party.Participant `json:"participant"`
+	Party       *party.Party       `json:"party"`
+	AuthToken   string             `json:"auth_token"`
+}
+
+// SearchMoviesQuery documents the query parameters of GET /api/movies/search
+type SearchMoviesQuery struct {
+	Q string `json:"q"`
+	party.SearchOptions
+}
+
+// SearchMoviesResponse is returned by GET /api/movies/search
+type SearchMoviesResponse = party.SearchResultsPayload
+
+// HealthResponse is returned by GET /api/health
+type HealthResponse struct {
+	Status    string `json:"status"`
+	Message   string `json:"message"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// ErrorResponse is the body of every error response
+type ErrorResponse = party.ErrorPayload
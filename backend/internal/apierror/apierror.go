@@ -0,0 +1,91 @@
+// Package apierror converts errors into the JSON error bodies and statuses
+// returned by the REST API and the WebSocket hub.
+package apierror
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/reelchoice/backend/internal/omdb"
+	"github.com/reelchoice/backend/internal/party"
+	"github.com/reelchoice/backend/internal/tmdb"
+)
+
+// statusByCode maps error codes to HTTP statuses
+var statusByCode = map[string]int{
+	party.CodeInvalidRequest:       http.StatusBadRequest,
+	party.CodeUnknownMessageType:   http.StatusBadRequest,
+	party.CodeUnauthorized:         http.StatusUnauthorized,
+	party.CodeForbidden:            http.StatusForbidden,
+	party.CodeNotHost:              http.StatusForbidden,
+	party.CodeNotParticipant:       http.StatusForbidden,
+	party.CodePartyNotFound:        http.StatusNotFound,
+	party.CodeParticipantNotFound:  http.StatusNotFound,
+	party.CodeUsernameTaken:        http.StatusConflict,
+	party.CodePartyBusy:            http.StatusConflict,
+	party.CodeWrongPhase:           http.StatusConflict,
+	party.CodeNominationInProgress: http.StatusConflict,
+	party.CodeNoNomination:         http.StatusConflict,
+	party.CodeInvalidVote:          http.StatusBadRequest,
+	party.CodeMovieNotFound:        http.StatusNotFound,
+	party.CodeEmptyNominationPool:  http.StatusConflict,
+	party.CodeInvalidRanking:       http.StatusBadRequest,
+	party.CodeInvalidSettings:      http.StatusBadRequest,
+	party.CodePartyFull:            http.StatusConflict,
+	party.CodeSuggestionLimit:      http.StatusConflict,
+	party.CodeNoVetoesLeft:         http.StatusConflict,
+	party.CodeMetadataUnavailable:  http.StatusServiceUnavailable,
+	party.CodeShuttingDown:         http.StatusServiceUnavailable,
+	party.CodeInternal:             http.StatusInternalServerError,
+}
+
+// FromError returns the coded error for err. Errors from the metadata
+// providers are translated; any other uncoded error is reported as an
+// internal error so that storage and other internals are not leaked.
+func FromError(err error) *party.Error {
+	var coded *party.Error
+	if errors.As(err, &coded) {
+		return coded
+	}
+
+	switch {
+	case errors.Is(err, tmdb.ErrNotFound), errors.Is(err, omdb.ErrNotFound):
+		return party.ErrMovieNotFound
+	case errors.Is(err, tmdb.ErrRateLimited), errors.Is(err, tmdb.ErrUnavailable), errors.Is(err, tmdb.ErrCircuitOpen),
+		errors.Is(err, omdb.ErrUnavailable):
+		return party.ErrMetadataUnavailable
+	}
+
+	slog.Error("Internal error", "error", err)
+	return party.ErrInternal
+}
+
+// Payload returns the error body for err
+func Payload(err error) party.ErrorPayload {
+	coded := FromError(err)
+	return party.ErrorPayload{
+		Code:    coded.Code,
+		Message: coded.Message,
+		Details: coded.Details,
+	}
+}
+
+// Write writes err as a JSON error response
+func Write(w http.ResponseWriter, err error) {
+	coded := FromError(err)
+
+	status, ok := statusByCode[coded.Code]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(party.ErrorPayload{
+		Code:    coded.Code,
+		Message: coded.Message,
+		Details: coded.Details,
+	})
+}
@@ -0,0 +1,59 @@
+package apierror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/reelchoice/backend/internal/catalog"
+	"github.com/reelchoice/backend/internal/metadata"
+	"github.com/reelchoice/backend/internal/omdb"
+	"github.com/reelchoice/backend/internal/party"
+	"github.com/reelchoice/backend/internal/tmdb"
+)
+
+func TestFromErrorClassifiesProviderErrors(t *testing.T) {
+	ctx := context.Background()
+	tmdbClient := tmdb.NewClient("test-key", nil)
+
+	registry := metadata.NewRegistry()
+	registry.Register("tmdb", tmdbClient)
+	composite, err := registry.Composite("tmdb")
+	if err != nil {
+		t.Fatalf("Composite: %v", err)
+	}
+
+	_, invalidID := tmdbClient.GetMovieDetails(ctx, "not-a-number")
+	_, blankQuery := tmdbClient.SearchMovies(ctx, "   ", party.SearchOptions{})
+	_, disabledProvider := composite.GetMovieDetails(ctx, "omdb:tt0133093")
+	_, blankCatalogQuery := (&catalog.Catalog{}).SearchMovies(ctx, " ", party.SearchOptions{})
+
+	tests := []struct {
+		name string
+		err  error
+		code string
+	}{
+		{"invalid TMDB ID", invalidID, party.CodeInvalidRequest},
+		{"blank TMDB query", blankQuery, party.CodeInvalidRequest},
+		{"disabled provider", disabledProvider, party.CodeMovieNotFound},
+		{"blank catalog query", blankCatalogQuery, party.CodeInvalidRequest},
+		{"TMDB not found", &tmdb.APIError{StatusCode: 404}, party.CodeMovieNotFound},
+		{"TMDB unavailable", fmt.Errorf("wrapped: %w", tmdb.ErrCircuitOpen), party.CodeMetadataUnavailable},
+		{"OMDb not found", fmt.Errorf("%w: Incorrect IMDb ID.", omdb.ErrNotFound), party.CodeMovieNotFound},
+		{"OMDb unavailable", fmt.Errorf("%w: Request limit reached!", omdb.ErrUnavailable), party.CodeMetadataUnavailable},
+		{"coded error", party.ErrPartyFull, party.CodePartyFull},
+		{"uncoded error", errors.New("redis: connection refused"), party.CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.err == nil {
+				t.Fatal("expected an error")
+			}
+			if got := FromError(tt.err).Code; got != tt.code {
+				t.Errorf("FromError(%v).Code = %q, want %q", tt.err, got, tt.code)
+			}
+		})
+	}
+}
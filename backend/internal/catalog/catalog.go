@@ -0,0 +1,224 @@
+package catalog
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/reelchoice/backend/internal/party"
+)
+
+// pageSize is the number of results returned per search page
+const pageSize = 20
+
+// requiredCSVColumns lists the columns every CSV catalog must have. The optional
+// columns are year, content_type, poster_path, overview, rating, runtime,
+// genres (separated by "|") and director.
+var requiredCSVColumns = []string{"id", "title"}
+
+// Catalog is a metadata provider backed by a local JSON or CSV file, used to
+// run without network access or as a stand-in when TMDB is unavailable
+type Catalog struct {
+	movies []party.Movie
+	byID   map[string]*party.Movie
+}
+
+// Load reads a catalog from a .json or .csv file
+func Load(path string) (*Catalog, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open catalog: %w", err)
+	}
+	defer file.Close()
+
+	var movies []party.Movie
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		movies, err = readJSON(file)
+	case ".csv":
+		movies, err = readCSV(file)
+	default:
+		return nil, fmt.Errorf("unsupported catalog format: %s", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return New(movies)
+}
+
+// New creates a catalog from the given movies
+func New(movies []party.Movie) (*Catalog, error) {
+	c := &Catalog{
+		movies: movies,
+		byID:   make(map[string]*party.Movie, len(movies)),
+	}
+
+	for i := range c.movies {
+		movie := &c.movies[i]
+		if movie.ID == "" || movie.Title == "" {
+			return nil, fmt.Errorf("catalog entry %d is missing an id or title", i+1)
+		}
+		if _, exists := c.byID[movie.ID]; exists {
+			return nil, fmt.Errorf("duplicate catalog ID: %s", movie.ID)
+		}
+		if movie.ContentType == "" {
+			movie.ContentType = party.ContentTypeMovie
+		}
+		c.byID[movie.ID] = movie
+	}
+
+	return c, nil
+}
+
+// SearchMovies finds catalog entries whose title contains the query
+func (c *Catalog) SearchMovies(ctx context.Context, query string, opts party.SearchOptions) (*party.SearchResult, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, party.ErrInvalidRequest.WithMessage("search query cannot be empty")
+	}
+
+	needle := strings.ToLower(strings.TrimSpace(query))
+	matches := make([]party.Movie, 0)
+	for _, movie := range c.movies {
+		if !strings.Contains(strings.ToLower(movie.Title), needle) {
+			continue
+		}
+		if !matchesOptions(movie, opts) {
+			continue
+		}
+		matches = append(matches, movie)
+	}
+
+	page := opts.Page
+	if page < 1 {
+		page = 1
+	}
+
+	start := (page - 1) * pageSize
+	if start > len(matches) {
+		start = len(matches)
+	}
+	end := start + pageSize
+	if end > len(matches) {
+		end = len(matches)
+	}
+
+	return &party.SearchResult{
+		Movies:       matches[start:end],
+		Page:         page,
+		TotalResults: len(matches),
+		TotalPages:   (len(matches) + pageSize - 1) / pageSize,
+	}, nil
+}
+
+// GetMovieDetails returns the catalog entry with the given ID
+func (c *Catalog) GetMovieDetails(ctx context.Context, id string) (*party.Movie, error) {
+	movie, ok := c.byID[id]
+	if !ok {
+		return nil, nil
+	}
+
+	result := *movie
+	return &result, nil
+}
+
+// matchesOptions reports whether a movie passes the search filters
+func matchesOptions(movie party.Movie, opts party.SearchOptions) bool {
+	switch opts.MediaType {
+	case "", party.ContentTypeMovie:
+		if movie.ContentType != party.ContentTypeMovie {
+			return false
+		}
+	case party.ContentTypeTV:
+		if movie.ContentType != party.ContentTypeTV {
+			return false
+		}
+	}
+
+	year := opts.PrimaryReleaseYear
+	if year == 0 {
+		year = opts.Year
+	}
+	if year != 0 && movie.Year != strconv.Itoa(year) {
+		return false
+	}
+
+	return true
+}
+
+// readJSON reads a catalog stored as a JSON array of movies
+func readJSON(r io.Reader) ([]party.Movie, error) {
+	var movies []party.Movie
+	if err := json.NewDecoder(r).Decode(&movies); err != nil {
+		return nil, fmt.Errorf("failed to decode catalog: %w", err)
+	}
+	return movies, nil
+}
+
+// readCSV reads a catalog stored as CSV with a header row
+func readCSV(r io.Reader) ([]party.Movie, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read catalog: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("catalog is empty")
+	}
+
+	// Map known columns to their position in the header
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range requiredCSVColumns {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("catalog is missing the %q column", required)
+		}
+	}
+
+	movies := make([]party.Movie, 0, len(records)-1)
+	for line, record := range records[1:] {
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		movie := party.Movie{
+			ID:          field("id"),
+			ContentType: field("content_type"),
+			Title:       field("title"),
+			Year:        field("year"),
+			PosterPath:  field("poster_path"),
+			Overview:    field("overview"),
+			Director:    field("director"),
+		}
+
+		if rating := field("rating"); rating != "" {
+			movie.Rating, err = strconv.ParseFloat(rating, 64)
+			if err != nil {
+				return nil, fmt.Errorf("catalog line %d: invalid rating %q", line+2, rating)
+			}
+		}
+		if runtime := field("runtime"); runtime != "" {
+			movie.Runtime, err = strconv.Atoi(runtime)
+			if err != nil {
+				return nil, fmt.Errorf("catalog line %d: invalid runtime %q", line+2, runtime)
+			}
+		}
+		if genres := field("genres"); genres != "" {
+			movie.Genres = strings.Split(genres, "|")
+		}
+
+		movies = append(movies, movie)
+	}
+
+	return movies, nil
+}
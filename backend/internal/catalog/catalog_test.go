@@ -0,0 +1,143 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/reelchoice/backend/internal/party"
+)
+
+func TestNewValidatesEntries(t *testing.T) {
+	tests := []struct {
+		name   string
+		movies []party.Movie
+	}{
+		{"missing ID", []party.Movie{{Title: "The Matrix"}}},
+		{"missing title", []party.Movie{{ID: "m1"}}},
+		{"duplicate ID", []party.Movie{{ID: "m1", Title: "The Matrix"}, {ID: "m1", Title: "Heat"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.movies); err == nil {
+				t.Error("New returned nil, want an error")
+			}
+		})
+	}
+}
+
+func TestSearchMovies(t *testing.T) {
+	c, err := New([]party.Movie{
+		{ID: "m1", Title: "The Matrix", Year: "1999"},
+		{ID: "m2", Title: "The Matrix Reloaded", Year: "2003"},
+		{ID: "s1", Title: "Matrix", Year: "1993", ContentType: party.ContentTypeTV},
+		{ID: "m3", Title: "Heat", Year: "1995"},
+	})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		opts  party.SearchOptions
+		want  []string
+	}{
+		{"title substring, movies by default", "matrix", party.SearchOptions{}, []string{"m1", "m2"}},
+		{"series", "MATRIX", party.SearchOptions{MediaType: party.ContentTypeTV}, []string{"s1"}},
+		{"year filter", "matrix", party.SearchOptions{Year: 2003}, []string{"m2"}},
+		{"primary release year wins over year", "matrix", party.SearchOptions{Year: 2003, PrimaryReleaseYear: 1999}, []string{"m1"}},
+		{"no matches", "alien", party.SearchOptions{}, []string{}},
+		{"page past the end", "matrix", party.SearchOptions{Page: 2}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := c.SearchMovies(context.Background(), tt.query, tt.opts)
+			if err != nil {
+				t.Fatalf("SearchMovies: %v", err)
+			}
+			if len(result.Movies) != len(tt.want) {
+				t.Fatalf("movies = %+v, want %v", result.Movies, tt.want)
+			}
+			for i, movie := range result.Movies {
+				if movie.ID != tt.want[i] {
+					t.Errorf("movie %d = %s, want %s", i, movie.ID, tt.want[i])
+				}
+			}
+		})
+	}
+
+	if _, err := c.SearchMovies(context.Background(), "  ", party.SearchOptions{}); !errors.Is(err, party.ErrInvalidRequest) {
+		t.Errorf("blank query error = %v, want ErrInvalidRequest", err)
+	}
+}
+
+func TestGetMovieDetails(t *testing.T) {
+	c, err := New([]party.Movie{{ID: "m1", Title: "The Matrix"}})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	movie, err := c.GetMovieDetails(context.Background(), "m1")
+	if err != nil || movie == nil || movie.Title != "The Matrix" {
+		t.Fatalf("GetMovieDetails(m1) = %+v, %v, want The Matrix", movie, err)
+	}
+
+	// Callers get a copy they may modify
+	movie.Title = "Changed"
+	if again, _ := c.GetMovieDetails(context.Background(), "m1"); again.Title != "The Matrix" {
+		t.Errorf("catalog entry was modified through a returned movie: %s", again.Title)
+	}
+
+	if movie, err := c.GetMovieDetails(context.Background(), "m2"); movie != nil || err != nil {
+		t.Errorf("GetMovieDetails(m2) = %+v, %v, want nil, nil", movie, err)
+	}
+}
+
+func TestLoadCSV(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog.csv")
+	data := "ID,Title,Year,Genres,Rating,Runtime,Content_Type\n" +
+		"m1,The Matrix,1999,Action|Sci-Fi,8.7,136,\n" +
+		"s1,Matrix,1993,,,,tv\n"
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	c, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	movie, _ := c.GetMovieDetails(context.Background(), "m1")
+	if movie == nil || movie.Rating != 8.7 || movie.Runtime != 136 || len(movie.Genres) != 2 ||
+		movie.ContentType != party.ContentTypeMovie {
+		t.Errorf("m1 = %+v, want the parsed CSV row", movie)
+	}
+	if series, _ := c.GetMovieDetails(context.Background(), "s1"); series == nil || series.ContentType != party.ContentTypeTV {
+		t.Errorf("s1 = %+v, want a series", series)
+	}
+}
+
+func TestLoadRejectsBadFiles(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"missing-title.csv": "id,year\nm1,1999\n",
+		"bad-rating.csv":    "id,title,rating\nm1,The Matrix,high\n",
+		"catalog.txt":       "m1,The Matrix\n",
+		"broken.json":       `[{"id": "m1"`,
+	}
+
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatalf("WriteFile: %v", err)
+		}
+		if _, err := Load(path); err == nil {
+			t.Errorf("Load(%s) returned nil, want an error", name)
+		}
+	}
+}
@@ -1,8 +1,9 @@
 package config
 
 import (
-	"log"
+	"log/slog"
 	"os"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -13,13 +14,29 @@ type Config struct {
 	RedisURL    string
 	DatabaseURL string
 	TMDBApiKey  string
+
+	// Metadata providers, tried in order when searching
+	MetadataProviders []string
+	OMDbApiKey        string
+	CatalogPath       string
+
+	// Logging
+	LogLevel  string // "debug", "info", "warn" or "error"
+	LogFormat string // "text" or "json"
+
+	// Tracing
+	TracingExporter string // "none", "otlp" or "stdout"
+	OTLPEndpoint    string // OTLP/HTTP collector URL, empty for the OpenTelemetry default
+
+	// Readiness
+	ReadinessCheckTMDB bool // Include TMDB reachability in /readyz as an optional check
 }
 
 // LoadConfig loads configuration from environment variables
 func LoadConfig() *Config {
 	// Try to load .env file (ignore error if file doesn't exist in production)
 	if err := godotenv.Load(); err != nil {
-		log.Printf("Warning: .env file not found: %v", err)
+		slog.Warn(".env file not found", "error", err)
 	}
 
 	config := &Config{
@@ -27,20 +44,57 @@ func LoadConfig() *Config {
 		RedisURL:    getEnvOrDefault("REDIS_URL", "redis://localhost:6379/0"),
 		DatabaseURL: getEnvOrDefault("DATABASE_URL", ""),
 		TMDBApiKey:  getEnvOrDefault("TMDB_API_KEY", ""),
+
+		MetadataProviders: splitList(getEnvOrDefault("METADATA_PROVIDERS", "tmdb")),
+		OMDbApiKey:        getEnvOrDefault("OMDB_API_KEY", ""),
+		CatalogPath:       getEnvOrDefault("CATALOG_PATH", ""),
+
+		LogLevel:  getEnvOrDefault("LOG_LEVEL", "info"),
+		LogFormat: getEnvOrDefault("LOG_FORMAT", "text"),
+
+		TracingExporter: getEnvOrDefault("TRACING_EXPORTER", "none"),
+		OTLPEndpoint:    getEnvOrDefault("OTLP_ENDPOINT", ""),
+
+		ReadinessCheckTMDB: getEnvOrDefault("READINESS_CHECK_TMDB", "false") == "true",
 	}
 
 	// Validate required configuration
 	if config.DatabaseURL == "" {
-		log.Fatal("DATABASE_URL environment variable is required")
+		fatal("DATABASE_URL environment variable is required")
 	}
 
-	if config.TMDBApiKey == "" {
-		log.Fatal("TMDB_API_KEY environment variable is required")
+	if len(config.MetadataProviders) == 0 {
+		fatal("METADATA_PROVIDERS must list at least one provider")
+	}
+
+	for _, provider := range config.MetadataProviders {
+		switch provider {
+		case "tmdb":
+			if config.TMDBApiKey == "" {
+				fatal("TMDB_API_KEY environment variable is required")
+			}
+		case "omdb":
+			if config.OMDbApiKey == "" {
+				fatal("OMDB_API_KEY environment variable is required when the omdb provider is enabled")
+			}
+		case "local":
+			if config.CatalogPath == "" {
+				fatal("CATALOG_PATH environment variable is required when the local provider is enabled")
+			}
+		default:
+			fatal("Unknown metadata provider in METADATA_PROVIDERS", "provider", provider)
+		}
 	}
 
 	return config
 }
 
+// fatal logs a configuration error and exits
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
+
 // getEnvOrDefault returns the environment variable value or a default value
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -48,3 +102,14 @@ func getEnvOrDefault(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// splitList splits a comma-separated environment value, dropping empty entries
+func splitList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -7,7 +7,9 @@ import (
 	"time"
 
 	"github.com/redis/go-redis/v9"
+	"github.com/reelchoice/backend/internal/metrics"
 	"github.com/reelchoice/backend/internal/party"
+	"github.com/reelchoice/backend/internal/tracing"
 )
 
 // RedisClient wraps the Redis client with our application logic
@@ -23,6 +25,8 @@ func NewRedisClient(redisURL string) (*RedisClient, error) {
 	}
 
 	client := redis.NewClient(opt)
+	client.AddHook(metrics.RedisHook{})
+	client.AddHook(tracing.RedisHook{})
 
 	// Test the connection
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -40,6 +44,11 @@ func (r *RedisClient) Close() error {
 	return r.client.Close()
 }
 
+// Ping checks the Redis connection
+func (r *RedisClient) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx).Err()
+}
+
 // GetParty fetches a party from Redis by ID
 func (r *RedisClient) GetParty(ctx context.Context, partyID string) (*party.Party, error) {
 	key := fmt.Sprintf("party:%s", partyID)
@@ -60,7 +69,15 @@ func (r *RedisClient) GetParty(ctx context.Context, partyID string) (*party.Part
 	return &p, nil
 }
 
-// SaveParty saves a party to Redis
+// partyTTL is how long a party is kept after its last change
+const partyTTL = 24 * time.Hour
+
+// partyDeadlinesKey is a sorted set of the IDs of parties with a pending
+// deadline, scored by when it passes. Any instance can rebuild its deadline
+// timers from it, so deadlines survive restarts.
+const partyDeadlinesKey = "party:deadlines"
+
+// SaveParty saves a party to Redis and records its next deadline
 func (r *RedisClient) SaveParty(ctx context.Context, p *party.Party) error {
 	key := fmt.Sprintf("party:%s", p.ID)
 
@@ -69,8 +86,21 @@ func (r *RedisClient) SaveParty(ctx context.Context, p *party.Party) error {
 		return fmt.Errorf("failed to marshal party data: %w", err)
 	}
 
-	// Set with 24 hour expiration for parties
-	if err := r.client.Set(ctx, key, jsonData, 24*time.Hour).Err(); err != nil {
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		// Set with 24 hour expiration for parties
+		pipe.Set(ctx, key, jsonData, partyTTL)
+
+		if deadline, ok := p.NextDeadline(); ok {
+			pipe.ZAdd(ctx, partyDeadlinesKey, redis.Z{Score: float64(deadline.Unix()), Member: p.ID})
+		} else {
+			pipe.ZRem(ctx, partyDeadlinesKey, p.ID)
+		}
+
+		// Drop deadlines of parties that have expired since
+		pipe.ZRemRangeByScore(ctx, partyDeadlinesKey, "-inf", fmt.Sprint(time.Now().Add(-partyTTL).Unix()))
+		return nil
+	})
+	if err != nil {
 		return fmt.Errorf("failed to save party to Redis: %w", err)
 	}
 
@@ -80,13 +110,93 @@ func (r *RedisClient) SaveParty(ctx context.Context, p *party.Party) error {
 // DeleteParty removes a party from Redis
 func (r *RedisClient) DeleteParty(ctx context.Context, partyID string) error {
 	key := fmt.Sprintf("party:%s", partyID)
-	return r.client.Del(ctx, key).Err()
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.ZRem(ctx, partyDeadlinesKey, partyID)
+		return nil
+	})
+	return err
+}
+
+// PartiesWithDeadlines returns the IDs of parties with a pending deadline
+func (r *RedisClient) PartiesWithDeadlines(ctx context.Context) ([]string, error) {
+	partyIDs, err := r.client.ZRange(ctx, partyDeadlinesKey, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list party deadlines: %w", err)
+	}
+	return partyIDs, nil
+}
+
+// TMDB cache namespaces. Each namespace has its own key prefix and TTLs.
+const (
+	TMDBSearchNamespace  = "search"
+	TMDBDetailsNamespace = "details"
+)
+
+// tmdbCacheTTL holds the fresh and stale lifetimes of a cache namespace
+type tmdbCacheTTL struct {
+	fresh time.Duration
+	stale time.Duration // Stale copies are served while TMDB is unavailable
+}
+
+// tmdbCacheTTLs maps each namespace to its TTLs. Search results change as new
+// titles are added; details for a given title rarely change.
+var tmdbCacheTTLs = map[string]tmdbCacheTTL{
+	TMDBSearchNamespace:  {fresh: time.Hour, stale: 24 * time.Hour},
+	TMDBDetailsNamespace: {fresh: 24 * time.Hour, stale: 7 * 24 * time.Hour},
+}
+
+// tmdbCacheLockDuration bounds how long one instance may hold a cache fill lock
+const tmdbCacheLockDuration = 5 * time.Second
+
+// GetCachedTMDBData gets cached TMDB data from the given namespace
+func (r *RedisClient) GetCachedTMDBData(ctx context.Context, namespace, key string) ([]byte, error) {
+	return r.getCached(ctx, fmt.Sprintf("tmdb:%s:%s", namespace, key))
+}
+
+// SetCachedTMDBData caches TMDB data in the given namespace
+func (r *RedisClient) SetCachedTMDBData(ctx context.Context, namespace, key string, data []byte) error {
+	ttl, err := tmdbNamespaceTTL(namespace)
+	if err != nil {
+		return err
+	}
+	return r.client.Set(ctx, fmt.Sprintf("tmdb:%s:%s", namespace, key), data, ttl.fresh).Err()
+}
+
+// GetStaleTMDBData gets a long-lived copy of cached TMDB data, served while TMDB is unavailable
+func (r *RedisClient) GetStaleTMDBData(ctx context.Context, namespace, key string) ([]byte, error) {
+	return r.getCached(ctx, fmt.Sprintf("tmdb:stale:%s:%s", namespace, key))
+}
+
+// SetStaleTMDBData stores a long-lived copy of TMDB data
+func (r *RedisClient) SetStaleTMDBData(ctx context.Context, namespace, key string, data []byte) error {
+	ttl, err := tmdbNamespaceTTL(namespace)
+	if err != nil {
+		return err
+	}
+	return r.client.Set(ctx, fmt.Sprintf("tmdb:stale:%s:%s", namespace, key), data, ttl.stale).Err()
 }
 
-// GetCachedTMDBData gets cached TMDB search results
-func (r *RedisClient) GetCachedTMDBData(ctx context.Context, query string) ([]byte, error) {
-	key := fmt.Sprintf("tmdb:search:%s", query)
+// AcquireTMDBCacheLock attempts to become the only instance filling a cache entry
+func (r *RedisClient) AcquireTMDBCacheLock(ctx context.Context, namespace, key string) (bool, error) {
+	lockKey := fmt.Sprintf("tmdb:lock:%s:%s", namespace, key)
+
+	result := r.client.SetNX(ctx, lockKey, "locked", tmdbCacheLockDuration)
+	if result.Err() != nil {
+		return false, fmt.Errorf("failed to acquire cache lock: %w", result.Err())
+	}
 
+	return result.Val(), nil
+}
+
+// ReleaseTMDBCacheLock releases a cache fill lock
+func (r *RedisClient) ReleaseTMDBCacheLock(ctx context.Context, namespace, key string) error {
+	lockKey := fmt.Sprintf("tmdb:lock:%s:%s", namespace, key)
+	return r.client.Del(ctx, lockKey).Err()
+}
+
+// getCached reads a cache entry, returning nil on a miss
+func (r *RedisClient) getCached(ctx context.Context, key string) ([]byte, error) {
 	data, err := r.client.Get(ctx, key).Result()
 	if err != nil {
 		if err == redis.Nil {
@@ -98,11 +208,27 @@ func (r *RedisClient) GetCachedTMDBData(ctx context.Context, query string) ([]by
 	return []byte(data), nil
 }
 
-// SetCachedTMDBData caches TMDB search results
-func (r *RedisClient) SetCachedTMDBData(ctx context.Context, query string, data []byte) error {
-	key := fmt.Sprintf("tmdb:search:%s", query)
-	// Cache for 1 hour
-	return r.client.Set(ctx, key, data, time.Hour).Err()
+// tmdbNamespaceTTL returns the TTLs for a cache namespace
+func tmdbNamespaceTTL(namespace string) (tmdbCacheTTL, error) {
+	ttl, ok := tmdbCacheTTLs[namespace]
+	if !ok {
+		return tmdbCacheTTL{}, fmt.Errorf("unknown TMDB cache namespace: %s", namespace)
+	}
+	return ttl, nil
+}
+
+// omdbDetailsTTL is how long OMDb title details are cached. OMDb has no
+// stale fallback, so there is a single lifetime.
+const omdbDetailsTTL = 24 * time.Hour
+
+// GetCachedOMDbDetails gets cached OMDb title details
+func (r *RedisClient) GetCachedOMDbDetails(ctx context.Context, imdbID string) ([]byte, error) {
+	return r.getCached(ctx, fmt.Sprintf("omdb:details:%s", imdbID))
+}
+
+// SetCachedOMDbDetails caches OMDb title details
+func (r *RedisClient) SetCachedOMDbDetails(ctx context.Context, imdbID string, data []byte) error {
+	return r.client.Set(ctx, fmt.Sprintf("omdb:details:%s", imdbID), data, omdbDetailsTTL).Err()
 }
 
 // Token management methods
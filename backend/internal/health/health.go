@@ -0,0 +1,169 @@
+// Package health checks the dependencies the server needs to serve traffic
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Statuses reported for single checks and for the server as a whole
+const (
+	StatusOK          = "ok"          // Everything is reachable
+	StatusDegraded    = "degraded"    // An optional dependency is unreachable
+	StatusUnavailable = "unavailable" // A required dependency is unreachable
+)
+
+// DefaultTimeout bounds each check so a hung dependency cannot hang the probe
+const DefaultTimeout = 2 * time.Second
+
+// CheckFunc reports whether a dependency is reachable
+type CheckFunc func(ctx context.Context) error
+
+type check struct {
+	name     string
+	fn       CheckFunc
+	required bool
+}
+
+// Checker runs dependency checks concurrently, each with its own timeout
+type Checker struct {
+	timeout time.Duration
+	checks  []check
+}
+
+// Result is the outcome of a single check
+type Result struct {
+	Status     string `json:"status"` // StatusOK or StatusUnavailable
+	Required   bool   `json:"required"`
+	DurationMs int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty"` // ErrorTimeout, ErrorUnreachable or ErrorShuttingDown
+}
+
+// Errors reported for a failed check. The underlying error is only logged,
+// since the probe is unauthenticated and errors may carry URLs or addresses.
+const (
+	ErrorTimeout      = "timeout"
+	ErrorUnreachable  = "unreachable"
+	ErrorShuttingDown = "shutting_down"
+)
+
+// ErrShuttingDown is returned by checks of components that have started
+// shutting down, so that traffic moves to other instances while they drain
+var ErrShuttingDown = errors.New("health: shutting down")
+
+// Report is the outcome of all checks. Its status is unavailable if any
+// required check failed and degraded if only optional checks failed.
+type Report struct {
+	Status    string            `json:"status"`
+	Checks    map[string]Result `json:"checks,omitempty"`
+	Timestamp int64             `json:"timestamp"`
+}
+
+// NewChecker creates a checker whose checks time out after timeout
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout}
+}
+
+// Add registers a check. A failing required check makes the server
+// unavailable; a failing optional check only degrades it.
+func (c *Checker) Add(name string, fn CheckFunc, required bool) {
+	c.checks = append(c.checks, check{name: name, fn: fn, required: required})
+}
+
+// Check runs all checks and reports their combined status
+func (c *Checker) Check(ctx context.Context) Report {
+	results := make([]Result, len(c.checks))
+
+	var wg sync.WaitGroup
+	for i, chk := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = c.run(ctx, chk)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{
+		Status:    StatusOK,
+		Checks:    make(map[string]Result, len(c.checks)),
+		Timestamp: time.Now().Unix(),
+	}
+	for i, chk := range c.checks {
+		result := results[i]
+		report.Checks[chk.name] = result
+		if result.Status == StatusOK {
+			continue
+		}
+		if chk.required {
+			report.Status = StatusUnavailable
+		} else if report.Status == StatusOK {
+			report.Status = StatusDegraded
+		}
+	}
+
+	return report
+}
+
+// run runs a single check under the checker's timeout
+func (c *Checker) run(ctx context.Context, chk check) Result {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := chk.fn(ctx)
+	result := Result{
+		Status:     StatusOK,
+		Required:   chk.required,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		result.Status = StatusUnavailable
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
+			result.Error = ErrorTimeout
+		case errors.Is(err, ErrShuttingDown):
+			result.Error = ErrorShuttingDown
+		default:
+			result.Error = ErrorUnreachable
+		}
+		slog.Warn("Health check failed", "check", chk.name, "error", err)
+	}
+	return result
+}
+
+// Liveness handles GET /healthz. It only reports that the process is
+// serving requests and never checks dependencies, so an orchestrator does
+// not restart instances over an outage elsewhere.
+func Liveness(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, Report{
+		Status:    StatusOK,
+		Timestamp: time.Now().Unix(),
+	})
+}
+
+// Readiness handles GET /readyz. It responds 200 when the server is ok or
+// degraded and 503 when a required dependency is unavailable, so traffic is
+// routed away from the instance until it recovers.
+func (c *Checker) Readiness(w http.ResponseWriter, r *http.Request) {
+	report := c.Check(r.Context())
+
+	status := http.StatusOK
+	if report.Status == StatusUnavailable {
+		status = http.StatusServiceUnavailable
+	}
+	writeJSON(w, status, report)
+}
+
+// writeJSON writes a JSON response that must not be cached
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
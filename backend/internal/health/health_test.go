@@ -0,0 +1,48 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadinessReportsFixedErrors(t *testing.T) {
+	checker := NewChecker(50 * time.Millisecond)
+	checker.Add("redis", func(ctx context.Context) error { return nil }, true)
+	checker.Add("tmdb", func(ctx context.Context) error {
+		return errors.New(`Get "http://tmdb.example/3/configuration?api_key=SECRET123": dial tcp: connection refused`)
+	}, false)
+	checker.Add("omdb", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}, false)
+
+	recorder := httptest.NewRecorder()
+	checker.Readiness(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	if recorder.Code != http.StatusOK {
+		t.Errorf("status = %d, want 200 with only optional checks failing", recorder.Code)
+	}
+	body := recorder.Body.String()
+	if strings.Contains(body, "SECRET123") || strings.Contains(body, "tmdb.example") {
+		t.Errorf("body leaks the dependency error: %s", body)
+	}
+
+	report := checker.Check(context.Background())
+	if report.Status != StatusDegraded {
+		t.Errorf("report status = %q, want %q", report.Status, StatusDegraded)
+	}
+	if got := report.Checks["tmdb"].Error; got != ErrorUnreachable {
+		t.Errorf("tmdb error = %q, want %q", got, ErrorUnreachable)
+	}
+	if got := report.Checks["omdb"].Error; got != ErrorTimeout {
+		t.Errorf("omdb error = %q, want %q", got, ErrorTimeout)
+	}
+	if got := report.Checks["redis"]; got.Status != StatusOK || got.Error != "" {
+		t.Errorf("redis result = %+v, want ok without an error", got)
+	}
+}
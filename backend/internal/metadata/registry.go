@@ -0,0 +1,149 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/reelchoice/backend/internal/logging"
+	"github.com/reelchoice/backend/internal/omdb"
+	"github.com/reelchoice/backend/internal/party"
+	"github.com/reelchoice/backend/internal/tmdb"
+)
+
+// DefaultProvider is the provider whose IDs are stored without a prefix.
+// TMDB IDs predate provider qualification, so they stay unqualified to keep
+// existing parties and clients working.
+const DefaultProvider = "tmdb"
+
+// Registry holds the metadata providers available to the application
+type Registry struct {
+	providers map[string]party.TMDBClient
+}
+
+// NewRegistry creates an empty provider registry
+func NewRegistry() *Registry {
+	return &Registry{
+		providers: make(map[string]party.TMDBClient),
+	}
+}
+
+// Register adds a provider under the given name, replacing any existing one
+func (r *Registry) Register(name string, provider party.TMDBClient) {
+	r.providers[name] = provider
+}
+
+// Get returns the provider registered under the given name
+func (r *Registry) Get(name string) (party.TMDBClient, bool) {
+	provider, ok := r.providers[name]
+	return provider, ok
+}
+
+// Composite creates a provider that tries the named providers in order
+func (r *Registry) Composite(names ...string) (*Composite, error) {
+	if len(names) == 0 {
+		return nil, fmt.Errorf("at least one metadata provider is required")
+	}
+
+	composite := &Composite{
+		providers: make(map[string]party.TMDBClient, len(names)),
+		order:     make([]string, 0, len(names)),
+	}
+
+	for _, name := range names {
+		provider, ok := r.providers[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown metadata provider: %s", name)
+		}
+		composite.providers[name] = provider
+		composite.order = append(composite.order, name)
+	}
+
+	return composite, nil
+}
+
+// Composite is a metadata provider that falls back between several providers.
+//
+// Searches try each provider in order and return the first successful result.
+// Only an unavailable provider is skipped; any other error, such as a blank
+// query, is returned as is since the next provider would reject it too.
+// Content IDs are qualified with the provider name ("omdb:tt0133093"), so
+// details lookups are always routed to the provider that issued the ID.
+type Composite struct {
+	providers map[string]party.TMDBClient
+	order     []string
+}
+
+// SearchMovies searches the providers in order, falling back while they are
+// unavailable
+func (c *Composite) SearchMovies(ctx context.Context, query string, opts party.SearchOptions) (*party.SearchResult, error) {
+	var lastErr error
+
+	for _, name := range c.order {
+		result, err := c.providers[name].SearchMovies(ctx, query, opts)
+		if err != nil {
+			if !unavailable(err) {
+				return nil, err
+			}
+			logging.FromContext(ctx).Warn("Metadata provider unavailable, trying next", "provider", name, "error", err)
+			lastErr = err
+			continue
+		}
+
+		qualified := *result
+		qualified.Movies = make([]party.Movie, len(result.Movies))
+		for i, movie := range result.Movies {
+			movie.ID = QualifyID(name, movie.ID)
+			qualified.Movies[i] = movie
+		}
+
+		return &qualified, nil
+	}
+
+	return nil, fmt.Errorf("all metadata providers failed: %w", lastErr)
+}
+
+// GetMovieDetails fetches details from the provider that issued the ID
+func (c *Composite) GetMovieDetails(ctx context.Context, id string) (*party.Movie, error) {
+	name, providerID := SplitID(id)
+
+	provider, ok := c.providers[name]
+	if !ok {
+		return nil, party.ErrMovieNotFound.WithMessage("metadata provider %s is not enabled", name).
+			WithDetail("movie_id", id)
+	}
+
+	movie, err := provider.GetMovieDetails(ctx, providerID)
+	if err != nil || movie == nil {
+		return movie, err
+	}
+
+	qualified := *movie
+	qualified.ID = QualifyID(name, movie.ID)
+	return &qualified, nil
+}
+
+// unavailable reports whether err means the provider could not serve the
+// request at the moment, as opposed to rejecting it
+func unavailable(err error) bool {
+	return errors.Is(err, tmdb.ErrUnavailable) || errors.Is(err, tmdb.ErrRateLimited) ||
+		errors.Is(err, tmdb.ErrCircuitOpen) || errors.Is(err, omdb.ErrUnavailable) ||
+		errors.Is(err, party.ErrMetadataUnavailable)
+}
+
+// QualifyID prefixes a provider-specific ID with the provider name
+func QualifyID(provider, id string) string {
+	if provider == DefaultProvider {
+		return id
+	}
+	return provider + ":" + id
+}
+
+// SplitID splits a qualified ID into its provider name and provider-specific ID
+func SplitID(id string) (provider, providerID string) {
+	if name, rest, found := strings.Cut(id, ":"); found {
+		return name, rest
+	}
+	return DefaultProvider, id
+}
@@ -0,0 +1,177 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/reelchoice/backend/internal/omdb"
+	"github.com/reelchoice/backend/internal/party"
+	"github.com/reelchoice/backend/internal/tmdb"
+)
+
+// fakeProvider returns fixed movies or a fixed error and counts searches
+type fakeProvider struct {
+	movies   []party.Movie
+	err      error
+	searches int
+}
+
+func (f *fakeProvider) SearchMovies(ctx context.Context, query string, opts party.SearchOptions) (*party.SearchResult, error) {
+	f.searches++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &party.SearchResult{Movies: f.movies, Page: 1, TotalResults: len(f.movies), TotalPages: 1}, nil
+}
+
+func (f *fakeProvider) GetMovieDetails(ctx context.Context, id string) (*party.Movie, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	for _, movie := range f.movies {
+		if movie.ID == id {
+			return &movie, nil
+		}
+	}
+	return nil, nil
+}
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry()
+	provider := &fakeProvider{}
+	registry.Register("tmdb", provider)
+
+	if got, ok := registry.Get("tmdb"); !ok || got != provider {
+		t.Errorf("Get(tmdb) = %v, %v, want the registered provider", got, ok)
+	}
+	if _, ok := registry.Get("omdb"); ok {
+		t.Error("Get(omdb) found an unregistered provider")
+	}
+	if _, err := registry.Composite(); err == nil {
+		t.Error("Composite() with no providers returned nil, want an error")
+	}
+	if _, err := registry.Composite("tmdb", "omdb"); err == nil {
+		t.Error("Composite with an unknown provider returned nil, want an error")
+	}
+}
+
+func TestCompositeSearchMovies(t *testing.T) {
+	unavailable := fmt.Errorf("%w: failed to execute request", tmdb.ErrUnavailable)
+
+	tests := []struct {
+		name         string
+		primary      error
+		wantCode     string // Expected error code, empty for success
+		wantFallback bool
+	}{
+		{name: "primary result", primary: nil},
+		{name: "unavailable primary falls back", primary: unavailable, wantFallback: true},
+		{name: "open circuit falls back", primary: tmdb.ErrCircuitOpen, wantFallback: true},
+		{name: "OMDb outage falls back", primary: fmt.Errorf("%w: Request limit reached!", omdb.ErrUnavailable), wantFallback: true},
+		{name: "coded error is returned", primary: party.ErrInvalidRequest.WithMessage("search query cannot be empty"), wantCode: party.CodeInvalidRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewRegistry()
+			primary := &fakeProvider{movies: []party.Movie{{ID: "603", Title: "The Matrix"}}, err: tt.primary}
+			secondary := &fakeProvider{movies: []party.Movie{{ID: "tt0133093", Title: "The Matrix"}}}
+			registry.Register("tmdb", primary)
+			registry.Register("omdb", secondary)
+
+			composite, err := registry.Composite("tmdb", "omdb")
+			if err != nil {
+				t.Fatalf("Composite: %v", err)
+			}
+
+			result, err := composite.SearchMovies(context.Background(), "matrix", party.SearchOptions{})
+			if tt.wantCode != "" {
+				var coded *party.Error
+				if !errors.As(err, &coded) || coded.Code != tt.wantCode {
+					t.Fatalf("SearchMovies error = %v, want code %s", err, tt.wantCode)
+				}
+				if secondary.searches != 0 {
+					t.Errorf("secondary provider searched %d times, want 0", secondary.searches)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SearchMovies: %v", err)
+			}
+
+			// TMDB IDs stay unqualified; other providers' IDs are prefixed
+			want := "603"
+			if tt.wantFallback {
+				want = "omdb:tt0133093"
+			}
+			if len(result.Movies) != 1 || result.Movies[0].ID != want {
+				t.Errorf("movies = %+v, want ID %s", result.Movies, want)
+			}
+		})
+	}
+}
+
+func TestCompositeSearchMoviesAllUnavailable(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("tmdb", &fakeProvider{err: tmdb.ErrCircuitOpen})
+	registry.Register("omdb", &fakeProvider{err: omdb.ErrUnavailable})
+
+	composite, err := registry.Composite("tmdb", "omdb")
+	if err != nil {
+		t.Fatalf("Composite: %v", err)
+	}
+
+	_, err = composite.SearchMovies(context.Background(), "matrix", party.SearchOptions{})
+	if !errors.Is(err, omdb.ErrUnavailable) {
+		t.Errorf("SearchMovies error = %v, want the last provider's error", err)
+	}
+}
+
+func TestCompositeGetMovieDetails(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("tmdb", &fakeProvider{movies: []party.Movie{{ID: "603", Title: "The Matrix"}}})
+	registry.Register("catalog", &fakeProvider{movies: []party.Movie{{ID: "m1", Title: "Local Movie"}}})
+
+	composite, err := registry.Composite("tmdb", "catalog")
+	if err != nil {
+		t.Fatalf("Composite: %v", err)
+	}
+
+	ctx := context.Background()
+	for _, id := range []string{"603", "catalog:m1"} {
+		movie, err := composite.GetMovieDetails(ctx, id)
+		if err != nil || movie == nil || movie.ID != id {
+			t.Errorf("GetMovieDetails(%s) = %+v, %v, want the movie with the same ID", id, movie, err)
+		}
+	}
+
+	_, err = composite.GetMovieDetails(ctx, "omdb:tt0133093")
+	if !errors.Is(err, party.ErrMovieNotFound) {
+		t.Errorf("GetMovieDetails for a disabled provider = %v, want ErrMovieNotFound", err)
+	}
+}
+
+func TestSplitID(t *testing.T) {
+	tests := []struct {
+		id           string
+		wantProvider string
+		wantID       string
+	}{
+		{"603", "tmdb", "603"},
+		{"tv/1396", "tmdb", "tv/1396"},
+		{"omdb:tt0133093", "omdb", "tt0133093"},
+		{"catalog:m1", "catalog", "m1"},
+	}
+
+	for _, tt := range tests {
+		provider, id := SplitID(tt.id)
+		if provider != tt.wantProvider || id != tt.wantID {
+			t.Errorf("SplitID(%q) = %q, %q, want %q, %q", tt.id, provider, id, tt.wantProvider, tt.wantID)
+		}
+		if QualifyID(provider, id) != tt.id {
+			t.Errorf("QualifyID(%q, %q) = %q, want %q", provider, id, QualifyID(provider, id), tt.id)
+		}
+	}
+}
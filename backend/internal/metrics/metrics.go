@@ -0,0 +1,126 @@
+// Package metrics defines the Prometheus metrics exported at /metrics
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
+)
+
+const namespace = "reelchoice"
+
+var (
+	// ActiveParties counts parties with at least one WebSocket connection on this instance
+	ActiveParties = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "active_parties",
+		Help:      "Parties with at least one WebSocket connection on this instance.",
+	})
+
+	// WSConnections counts open WebSocket connections on this instance
+	WSConnections = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "ws_connections",
+		Help:      "Open WebSocket connections on this instance.",
+	})
+
+	// WSMessages counts WebSocket messages received, by message type
+	WSMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "ws_messages_total",
+		Help:      "WebSocket messages received, by message type.",
+	}, []string{"type"})
+
+	// WSErrors counts error messages sent to WebSocket clients, by error code
+	WSErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "ws_errors_total",
+		Help:      "Error messages sent to WebSocket clients, by error code.",
+	}, []string{"code"})
+
+	// TMDBRequestDuration measures TMDB API requests, by HTTP status
+	TMDBRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "tmdb_request_duration_seconds",
+		Help:      "TMDB API request latency, by HTTP status (\"error\" for network failures).",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"status"})
+
+	// TMDBCacheLookups counts TMDB cache outcomes. The hit rate is
+	// hit / (hit + miss).
+	TMDBCacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tmdb_cache_lookups_total",
+		Help:      "TMDB cache lookups, by result (hit, miss, coalesced or stale).",
+	}, []string{"result"})
+
+	// RedisOpDuration measures Redis commands, by command name
+	RedisOpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "redis_operation_duration_seconds",
+		Help:      "Redis command latency, by command.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"operation"})
+
+	// LockContention counts party operations rejected because another
+	// operation held the party lock
+	LockContention = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "party_lock_contention_total",
+		Help:      "Party operations that failed to acquire the party lock.",
+	})
+
+	// RCVRounds measures how many rounds instant-runoff counts take
+	RCVRounds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rcv_rounds",
+		Help:      "Rounds needed by each instant-runoff count.",
+		Buckets:   prometheus.LinearBuckets(1, 1, 10),
+	})
+)
+
+// Cache lookup results
+const (
+	CacheHit       = "hit"
+	CacheMiss      = "miss"
+	CacheCoalesced = "coalesced"
+	CacheStale     = "stale"
+)
+
+// Handler serves the metrics in the Prometheus text format
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// RedisHook times Redis commands. Add it to a client with AddHook.
+type RedisHook struct{}
+
+// DialHook implements redis.Hook
+func (RedisHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+// ProcessHook implements redis.Hook
+func (RedisHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		start := time.Now()
+		err := next(ctx, cmd)
+		RedisOpDuration.WithLabelValues(cmd.Name()).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
+
+// ProcessPipelineHook implements redis.Hook
+func (RedisHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		start := time.Now()
+		err := next(ctx, cmds)
+		RedisOpDuration.WithLabelValues("pipeline").Observe(time.Since(start).Seconds())
+		return err
+	}
+}
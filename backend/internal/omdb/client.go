@@ -0,0 +1,315 @@
+package omdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reelchoice/backend/internal/database"
+	"github.com/reelchoice/backend/internal/party"
+	"github.com/reelchoice/backend/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// pageSize is the fixed number of results OMDb returns per search page
+const pageSize = 10
+
+// Sentinel errors for OMDb failures. Use errors.Is to check an error returned
+// by the client against them.
+var (
+	ErrNotFound    = errors.New("omdb: title not found")
+	ErrUnavailable = errors.New("omdb: service unavailable")
+)
+
+// apiError converts the Error field of an unsuccessful OMDb response, such as
+// "Incorrect IMDb ID." or "Request limit reached!"
+func apiError(message string) error {
+	switch {
+	case strings.Contains(message, "not found"), strings.HasPrefix(message, "Incorrect IMDb ID"):
+		return fmt.Errorf("%w: %s", ErrNotFound, message)
+	case message == "Too many results.":
+		return party.ErrInvalidRequest.WithMessage("search query matches too many titles")
+	default:
+		return fmt.Errorf("%w: %s", ErrUnavailable, message)
+	}
+}
+
+// Client represents an OMDb API client
+type Client struct {
+	apiKey      string
+	redisClient *database.RedisClient
+	httpClient  *http.Client
+	baseURL     string
+}
+
+// OMDbSearchResponse represents the search response from OMDb
+type OMDbSearchResponse struct {
+	Search []struct {
+		Title  string `json:"Title"`
+		Year   string `json:"Year"`
+		IMDbID string `json:"imdbID"`
+		Type   string `json:"Type"`
+		Poster string `json:"Poster"`
+	} `json:"Search"`
+	TotalResults string `json:"totalResults"`
+	Response     string `json:"Response"`
+	Error        string `json:"Error"`
+}
+
+// OMDbTitleResponse represents the title details response from OMDb
+type OMDbTitleResponse struct {
+	Title        string `json:"Title"`
+	Year         string `json:"Year"`
+	Rated        string `json:"Rated"`
+	Runtime      string `json:"Runtime"`
+	Genre        string `json:"Genre"`
+	Director     string `json:"Director"`
+	Actors       string `json:"Actors"`
+	Plot         string `json:"Plot"`
+	Poster       string `json:"Poster"`
+	IMDbRating   string `json:"imdbRating"`
+	IMDbID       string `json:"imdbID"`
+	Type         string `json:"Type"`
+	TotalSeasons string `json:"totalSeasons"`
+	Response     string `json:"Response"`
+	Error        string `json:"Error"`
+}
+
+// NewClient creates a new OMDb client
+func NewClient(apiKey string, redisClient *database.RedisClient) *Client {
+	return &Client{
+		apiKey:      apiKey,
+		redisClient: redisClient,
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		baseURL: "https://www.omdbapi.com/",
+	}
+}
+
+// SearchMovies searches for movies and series using the OMDb API
+func (c *Client) SearchMovies(ctx context.Context, query string, opts party.SearchOptions) (*party.SearchResult, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, party.ErrInvalidRequest.WithMessage("search query cannot be empty")
+	}
+
+	page := opts.Page
+	if page < 1 {
+		page = 1
+	}
+
+	params := url.Values{}
+	params.Set("s", query)
+	params.Set("page", strconv.Itoa(page))
+
+	switch opts.MediaType {
+	case "", party.ContentTypeMovie:
+		params.Set("type", "movie")
+	case party.ContentTypeTV:
+		params.Set("type", "series")
+	}
+
+	year := opts.PrimaryReleaseYear
+	if year == 0 {
+		year = opts.Year
+	}
+	if year != 0 {
+		params.Set("y", strconv.Itoa(year))
+	}
+
+	var searchResponse OMDbSearchResponse
+	if err := c.getJSON(ctx, params, &searchResponse); err != nil {
+		return nil, err
+	}
+
+	// OMDb reports an empty search as an error response
+	if searchResponse.Response != "True" {
+		if searchResponse.Error == "Movie not found!" {
+			return &party.SearchResult{Movies: []party.Movie{}, Page: page}, nil
+		}
+		return nil, apiError(searchResponse.Error)
+	}
+
+	movies := make([]party.Movie, 0, len(searchResponse.Search))
+	for _, result := range searchResponse.Search {
+		movies = append(movies, party.Movie{
+			ID:          result.IMDbID,
+			ContentType: contentType(result.Type),
+			Title:       result.Title,
+			Year:        releaseYear(result.Year),
+			PosterPath:  notAvailable(result.Poster),
+		})
+	}
+
+	totalResults, _ := strconv.Atoi(searchResponse.TotalResults)
+
+	return &party.SearchResult{
+		Movies:       movies,
+		Page:         page,
+		TotalResults: totalResults,
+		TotalPages:   (totalResults + pageSize - 1) / pageSize,
+	}, nil
+}
+
+// GetMovieDetails gets detailed information about a title by its IMDb ID
+func (c *Client) GetMovieDetails(ctx context.Context, imdbID string) (*party.Movie, error) {
+	if imdbID == "" {
+		return nil, party.ErrInvalidRequest.WithMessage("movie ID cannot be empty")
+	}
+
+	// Check cache first
+	cachedData, err := c.redisClient.GetCachedOMDbDetails(ctx, imdbID)
+	if err == nil && cachedData != nil {
+		var movie party.Movie
+		if err := json.Unmarshal(cachedData, &movie); err == nil {
+			return &movie, nil
+		}
+	}
+
+	params := url.Values{}
+	params.Set("i", imdbID)
+	params.Set("plot", "short")
+
+	var title OMDbTitleResponse
+	if err := c.getJSON(ctx, params, &title); err != nil {
+		return nil, err
+	}
+
+	if title.Response != "True" {
+		return nil, apiError(title.Error)
+	}
+
+	movie := &party.Movie{
+		ID:            title.IMDbID,
+		ContentType:   contentType(title.Type),
+		Title:         title.Title,
+		Year:          releaseYear(title.Year),
+		PosterPath:    notAvailable(title.Poster),
+		Overview:      notAvailable(title.Plot),
+		Certification: notAvailable(title.Rated),
+		Director:      notAvailable(title.Director),
+		Genres:        splitList(title.Genre),
+	}
+
+	if rating, err := strconv.ParseFloat(title.IMDbRating, 64); err == nil {
+		movie.Rating = rating
+	}
+
+	// Runtime is formatted as "136 min"
+	if runtime, err := strconv.Atoi(strings.TrimSuffix(title.Runtime, " min")); err == nil {
+		movie.Runtime = runtime
+	}
+
+	if seasons, err := strconv.Atoi(title.TotalSeasons); err == nil {
+		movie.SeasonCount = seasons
+	}
+
+	// OMDb lists actors without their characters
+	for _, actor := range splitList(title.Actors) {
+		movie.Cast = append(movie.Cast, party.CastMember{Name: actor})
+	}
+
+	// Cache the result
+	movieData, err := json.Marshal(movie)
+	if err == nil {
+		c.redisClient.SetCachedOMDbDetails(ctx, imdbID, movieData)
+	}
+
+	return movie, nil
+}
+
+// getJSON performs a GET request against the OMDb API and decodes the JSON response into out
+func (c *Client) getJSON(ctx context.Context, params url.Values, out interface{}) (err error) {
+	// The span leaves out the URL, which carries the API key
+	ctx, span := tracing.StartClient(ctx, "omdb GET", attribute.String("http.request.method", "GET"))
+	defer func() {
+		tracing.RecordError(ctx, err)
+		span.End()
+	}()
+
+	params.Set("apikey", c.apiKey)
+	fullURL := fmt.Sprintf("%s?%s", c.baseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fullURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", redactURL(err))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("%w: failed to execute request: %v", ErrUnavailable, redactURL(err))
+	}
+	defer resp.Body.Close()
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: OMDb API returned status %d", ErrUnavailable, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("%w: failed to decode response: %v", ErrUnavailable, err)
+	}
+
+	return nil
+}
+
+// redactURL drops the request URL, which carries the API key, from a
+// url.Error so it stays out of returned errors and spans
+func redactURL(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
+
+// contentType maps an OMDb title type to a party content type
+func contentType(omdbType string) string {
+	switch omdbType {
+	case "series":
+		return party.ContentTypeTV
+	case "episode":
+		return party.ContentTypeEpisode
+	default:
+		return party.ContentTypeMovie
+	}
+}
+
+// releaseYear extracts the first year from OMDb years such as "2008–2013"
+func releaseYear(year string) string {
+	if len(year) >= 4 {
+		return year[:4]
+	}
+	return ""
+}
+
+// notAvailable converts OMDb's "N/A" placeholder to an empty string
+func notAvailable(value string) string {
+	if value == "N/A" {
+		return ""
+	}
+	return value
+}
+
+// splitList splits a comma-separated OMDb list such as "Action, Sci-Fi"
+func splitList(value string) []string {
+	value = notAvailable(value)
+	if value == "" {
+		return nil
+	}
+
+	items := strings.Split(value, ",")
+	for i, item := range items {
+		items[i] = strings.TrimSpace(item)
+	}
+	return items
+}
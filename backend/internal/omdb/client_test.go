@@ -0,0 +1,136 @@
+package omdb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/reelchoice/backend/internal/party"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestNetworkErrorsOmitAPIKey(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	client := NewClient("SECRET123", nil)
+	client.baseURL = "http://127.0.0.1:1/"
+
+	_, err := client.SearchMovies(context.Background(), "The Matrix", party.SearchOptions{})
+	if !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("SearchMovies error = %v, want ErrUnavailable", err)
+	}
+	if strings.Contains(err.Error(), "SECRET123") {
+		t.Errorf("error leaks the API key: %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) == 0 {
+		t.Fatal("no spans recorded")
+	}
+	for _, span := range spans {
+		if strings.Contains(span.Status().Description, "SECRET123") {
+			t.Errorf("span %s status leaks the API key: %s", span.Name(), span.Status().Description)
+		}
+		for _, event := range span.Events() {
+			for _, attr := range event.Attributes {
+				if strings.Contains(attr.Value.Emit(), "SECRET123") {
+					t.Errorf("span %s event leaks the API key: %s", span.Name(), attr.Value.Emit())
+				}
+			}
+		}
+	}
+}
+
+// newTestClient returns a client whose requests are answered with body
+func newTestClient(t *testing.T, body string) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient("test-key", nil)
+	client.baseURL = server.URL + "/"
+	return client
+}
+
+func TestSearchMovies(t *testing.T) {
+	client := newTestClient(t, `{
+		"Search": [
+			{"Title": "The Matrix", "Year": "1999", "imdbID": "tt0133093", "Type": "movie", "Poster": "https://example.com/matrix.jpg"},
+			{"Title": "Matrix", "Year": "1993–", "imdbID": "tt0106062", "Type": "series", "Poster": "N/A"}
+		],
+		"totalResults": "11",
+		"Response": "True"
+	}`)
+
+	result, err := client.SearchMovies(context.Background(), "matrix", party.SearchOptions{})
+	if err != nil {
+		t.Fatalf("SearchMovies: %v", err)
+	}
+	if result.TotalResults != 11 || result.TotalPages != 2 || len(result.Movies) != 2 {
+		t.Fatalf("result = %+v, want 2 movies of 11 across 2 pages", result)
+	}
+
+	movie := result.Movies[0]
+	if movie.ID != "tt0133093" || movie.ContentType != party.ContentTypeMovie || movie.PosterPath == "" {
+		t.Errorf("first result = %+v, want The Matrix with its poster", movie)
+	}
+
+	series := result.Movies[1]
+	if series.ContentType != party.ContentTypeTV || series.Year != "1993" || series.PosterPath != "" {
+		t.Errorf("second result = %+v, want a 1993 series with no poster", series)
+	}
+}
+
+func TestSearchMoviesErrorResponses(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantEmpty bool
+		wantErr   error
+	}{
+		{"no matches is an empty result", `{"Response": "False", "Error": "Movie not found!"}`, true, nil},
+		{"too many results is a bad request", `{"Response": "False", "Error": "Too many results."}`, false, party.ErrInvalidRequest},
+		{"request limit is unavailable", `{"Response": "False", "Error": "Request limit reached!"}`, false, ErrUnavailable},
+		{"malformed body is unavailable", `<html>`, false, ErrUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := newTestClient(t, tt.body).SearchMovies(context.Background(), "matrix", party.SearchOptions{})
+			if tt.wantEmpty {
+				if err != nil || result == nil || result.Movies == nil || len(result.Movies) != 0 {
+					t.Errorf("SearchMovies = %+v, %v, want an empty result", result, err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("SearchMovies error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNormalization(t *testing.T) {
+	if got := notAvailable("N/A"); got != "" {
+		t.Errorf(`notAvailable("N/A") = %q, want ""`, got)
+	}
+	if got := releaseYear("2008–2013"); got != "2008" {
+		t.Errorf("releaseYear = %q, want 2008", got)
+	}
+	if got := splitList("N/A"); got != nil {
+		t.Errorf(`splitList("N/A") = %v, want nil`, got)
+	}
+	if got := splitList("Action, Sci-Fi"); len(got) != 2 || got[1] != "Sci-Fi" {
+		t.Errorf("splitList = %q, want [Action Sci-Fi]", got)
+	}
+}
@@ -0,0 +1,103 @@
+package party
+
+import "fmt"
+
+// Error is a failure with a stable code that clients can branch on. The
+// transport layers map codes to HTTP statuses and WebSocket error messages.
+type Error struct {
+	Code    string
+	Message string
+	Details map[string]interface{}
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is matches errors by code, so an error with a custom message or details
+// still matches its sentinel with errors.Is
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// WithMessage returns a copy of the error with a formatted message
+func (e *Error) WithMessage(format string, args ...interface{}) *Error {
+	copied := e.clone()
+	copied.Message = fmt.Sprintf(format, args...)
+	return copied
+}
+
+// WithDetail returns a copy of the error with an additional detail
+func (e *Error) WithDetail(key string, value interface{}) *Error {
+	copied := e.clone()
+	copied.Details[key] = value
+	return copied
+}
+
+// clone copies the error so sentinels are never modified
+func (e *Error) clone() *Error {
+	details := make(map[string]interface{}, len(e.Details)+1)
+	for key, value := range e.Details {
+		details[key] = value
+	}
+	return &Error{Code: e.Code, Message: e.Message, Details: details}
+}
+
+// Error codes
+const (
+	CodeInvalidRequest       = "invalid_request"
+	CodeUnknownMessageType   = "unknown_message_type"
+	CodeUnauthorized         = "unauthorized"
+	CodeForbidden            = "forbidden"
+	CodeNotHost              = "not_host"
+	CodeNotParticipant       = "not_participant"
+	CodePartyNotFound        = "party_not_found"
+	CodeParticipantNotFound  = "participant_not_found"
+	CodeUsernameTaken        = "username_taken"
+	CodePartyBusy            = "party_busy"
+	CodeWrongPhase           = "wrong_phase"
+	CodeNominationInProgress = "nomination_in_progress"
+	CodeNoNomination         = "no_nomination"
+	CodeInvalidVote          = "invalid_vote"
+	CodeMovieNotFound        = "movie_not_found"
+	CodeEmptyNominationPool  = "empty_nomination_pool"
+	CodeInvalidRanking       = "invalid_ranking"
+	CodeInvalidSettings      = "invalid_settings"
+	CodePartyFull            = "party_full"
+	CodeSuggestionLimit      = "suggestion_limit_reached"
+	CodeNoVetoesLeft         = "no_vetoes_left"
+	CodeMetadataUnavailable  = "metadata_unavailable"
+	CodeShuttingDown         = "shutting_down"
+	CodeInternal             = "internal_error"
+)
+
+// Sentinel errors. Use errors.Is to check for them and WithMessage or
+// WithDetail to add context.
+var (
+	ErrInvalidRequest       = &Error{Code: CodeInvalidRequest, Message: "invalid request"}
+	ErrUnknownMessageType   = &Error{Code: CodeUnknownMessageType, Message: "unknown message type"}
+	ErrUnauthorized         = &Error{Code: CodeUnauthorized, Message: "authentication required"}
+	ErrForbidden            = &Error{Code: CodeForbidden, Message: "not allowed"}
+	ErrNotHost              = &Error{Code: CodeNotHost, Message: "only the host can perform this action"}
+	ErrNotParticipant       = &Error{Code: CodeNotParticipant, Message: "you are not a participant in this party"}
+	ErrPartyNotFound        = &Error{Code: CodePartyNotFound, Message: "party not found"}
+	ErrParticipantNotFound  = &Error{Code: CodeParticipantNotFound, Message: "participant not found"}
+	ErrUsernameTaken        = &Error{Code: CodeUsernameTaken, Message: "username already taken in this party"}
+	ErrPartyBusy            = &Error{Code: CodePartyBusy, Message: "party is currently being modified by another request"}
+	ErrWrongPhase           = &Error{Code: CodeWrongPhase, Message: "action not allowed in the current phase"}
+	ErrNominationInProgress = &Error{Code: CodeNominationInProgress, Message: "another nomination is already in progress"}
+	ErrNoNomination         = &Error{Code: CodeNoNomination, Message: "no nomination in progress"}
+	ErrInvalidVote          = &Error{Code: CodeInvalidVote, Message: "vote must be 'yay', 'nay' or 'abstain'"}
+	ErrMovieNotFound        = &Error{Code: CodeMovieNotFound, Message: "movie not found"}
+	ErrEmptyNominationPool  = &Error{Code: CodeEmptyNominationPool, Message: "no movies have been nominated"}
+	ErrInvalidRanking       = &Error{Code: CodeInvalidRanking, Message: "invalid ranking"}
+	ErrInvalidSettings      = &Error{Code: CodeInvalidSettings, Message: "invalid party settings"}
+	ErrPartyFull            = &Error{Code: CodePartyFull, Message: "party is full"}
+	ErrSuggestionLimit      = &Error{Code: CodeSuggestionLimit, Message: "suggestion limit reached"}
+	ErrNoVetoesLeft         = &Error{Code: CodeNoVetoesLeft, Message: "no vetoes left"}
+	ErrMetadataUnavailable  = &Error{Code: CodeMetadataUnavailable, Message: "movie data is temporarily unavailable"}
+	ErrShuttingDown         = &Error{Code: CodeShuttingDown, Message: "server is shutting down, retry shortly"}
+	ErrInternal             = &Error{Code: CodeInternal, Message: "internal server error"}
+)
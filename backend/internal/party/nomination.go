@@ -0,0 +1,87 @@
+package party
+
+// Nomination rules decide whether a suggested movie joins the nomination pool
+const (
+	NominationRuleMajority      = "majority"      // More yays than nays among the votes cast
+	NominationRuleSupermajority = "supermajority" // Yays make up at least nomination_threshold of the votes cast
+	NominationRuleUnanimous     = "unanimous"     // Every vote cast is a yay
+	NominationRuleFixed         = "fixed"         // At least nomination_yay_count yays
+	NominationRuleAutoAccept    = "auto_accept"   // Suggestions join the pool without a vote
+)
+
+// Vote values
+const (
+	VoteYay     = "yay"
+	VoteNay     = "nay"
+	VoteAbstain = "abstain" // Counts toward completion and quorum but not the rule
+)
+
+// thresholdEpsilon absorbs floating point error when comparing vote shares
+const thresholdEpsilon = 1e-9
+
+// NominationPasses applies the party's nomination rule to the votes on a
+// nomination. votes maps participant IDs to their vote and weights maps every
+// participant who could have voted to their voter weight. Votes, the quorum
+// and the fixed yay count are all measured in weight.
+func (s PartySettings) NominationPasses(votes map[string]string, hostID string, weights map[string]float64) bool {
+	if s.nominationRule() == NominationRuleAutoAccept {
+		return true
+	}
+
+	yays, nays, voted, total := 0.0, 0.0, 0.0, 0.0
+	for _, weight := range weights {
+		total += weight
+	}
+	for userID, vote := range votes {
+		weight, ok := weights[userID]
+		if !ok {
+			weight = DefaultVoterWeight
+		}
+		voted += weight
+		switch vote {
+		case VoteYay:
+			yays += weight
+		case VoteNay:
+			nays += weight
+		}
+	}
+	cast := yays + nays
+
+	// A host nay rejects the nomination outright
+	if s.HostVeto && votes[hostID] == VoteNay {
+		return false
+	}
+
+	// Nothing to decide without yays or nays, or with fewer votes than the quorum
+	if cast == 0 || voted < s.NominationQuorum*total-thresholdEpsilon {
+		return false
+	}
+
+	switch s.nominationRule() {
+	case NominationRuleSupermajority:
+		return yays >= s.supermajorityThreshold()*cast-thresholdEpsilon
+	case NominationRuleUnanimous:
+		return nays == 0
+	case NominationRuleFixed:
+		return yays >= float64(s.NominationYayCount)-thresholdEpsilon
+	default:
+		return yays > nays+thresholdEpsilon
+	}
+}
+
+// nominationRule returns the nomination rule, falling back to simple
+// majority for parties created before rules existed
+func (s PartySettings) nominationRule() string {
+	if s.NominationRule == "" {
+		return NominationRuleMajority
+	}
+	return s.NominationRule
+}
+
+// supermajorityThreshold returns the share of yays a supermajority needs
+func (s PartySettings) supermajorityThreshold() float64 {
+	if s.NominationThreshold == 0 {
+		return DefaultNominationThreshold
+	}
+	return s.NominationThreshold
+}
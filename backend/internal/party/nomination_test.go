@@ -0,0 +1,219 @@
+package party
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// equalWeights gives each participant the default voter weight
+func equalWeights(userIDs ...string) map[string]float64 {
+	weights := make(map[string]float64, len(userIDs))
+	for _, userID := range userIDs {
+		weights[userID] = DefaultVoterWeight
+	}
+	return weights
+}
+
+func TestNominationPasses(t *testing.T) {
+	four := equalWeights("host", "a", "b", "c")
+
+	tests := []struct {
+		name     string
+		settings PartySettings
+		votes    map[string]string
+		weights  map[string]float64
+		want     bool
+	}{
+		{
+			name:     "majority passes with more yays than nays",
+			settings: PartySettings{NominationRule: NominationRuleMajority},
+			votes:    map[string]string{"host": VoteYay, "a": VoteYay, "b": VoteNay},
+			weights:  four,
+			want:     true,
+		},
+		{
+			name:     "majority fails on a tie",
+			settings: PartySettings{NominationRule: NominationRuleMajority},
+			votes:    map[string]string{"host": VoteYay, "a": VoteNay},
+			weights:  four,
+			want:     false,
+		},
+		{
+			name:     "majority is the default rule",
+			settings: PartySettings{},
+			votes:    map[string]string{"host": VoteYay, "a": VoteYay, "b": VoteNay},
+			weights:  four,
+			want:     true,
+		},
+		{
+			name:     "supermajority passes at the threshold",
+			settings: PartySettings{NominationRule: NominationRuleSupermajority, NominationThreshold: 2.0 / 3.0},
+			votes:    map[string]string{"host": VoteYay, "a": VoteYay, "b": VoteNay},
+			weights:  four,
+			want:     true,
+		},
+		{
+			name:     "supermajority fails below the threshold",
+			settings: PartySettings{NominationRule: NominationRuleSupermajority, NominationThreshold: 0.75},
+			votes:    map[string]string{"host": VoteYay, "a": VoteYay, "b": VoteNay},
+			weights:  four,
+			want:     false,
+		},
+		{
+			name:     "unanimous passes with only yays",
+			settings: PartySettings{NominationRule: NominationRuleUnanimous},
+			votes:    map[string]string{"host": VoteYay, "a": VoteYay, "b": VoteYay, "c": VoteYay},
+			weights:  four,
+			want:     true,
+		},
+		{
+			name:     "unanimous fails on a single nay",
+			settings: PartySettings{NominationRule: NominationRuleUnanimous},
+			votes:    map[string]string{"host": VoteYay, "a": VoteYay, "b": VoteYay, "c": VoteNay},
+			weights:  four,
+			want:     false,
+		},
+		{
+			name:     "fixed passes with enough yays despite more nays",
+			settings: PartySettings{NominationRule: NominationRuleFixed, NominationYayCount: 2},
+			votes:    map[string]string{"host": VoteYay, "a": VoteYay, "b": VoteNay, "c": VoteNay},
+			weights:  equalWeights("host", "a", "b", "c", "d"),
+			want:     true,
+		},
+		{
+			name:     "fixed fails with too few yays",
+			settings: PartySettings{NominationRule: NominationRuleFixed, NominationYayCount: 2},
+			votes:    map[string]string{"host": VoteYay, "a": VoteAbstain},
+			weights:  four,
+			want:     false,
+		},
+		{
+			name:     "auto accept passes without votes",
+			settings: PartySettings{NominationRule: NominationRuleAutoAccept},
+			votes:    map[string]string{},
+			weights:  four,
+			want:     true,
+		},
+		{
+			name:     "quorum not met",
+			settings: PartySettings{NominationRule: NominationRuleMajority, NominationQuorum: 0.75},
+			votes:    map[string]string{"host": VoteYay, "a": VoteYay},
+			weights:  four,
+			want:     false,
+		},
+		{
+			name:     "quorum met",
+			settings: PartySettings{NominationRule: NominationRuleMajority, NominationQuorum: 0.75},
+			votes:    map[string]string{"host": VoteYay, "a": VoteYay, "b": VoteNay},
+			weights:  four,
+			want:     true,
+		},
+		{
+			name:     "abstentions count toward the quorum",
+			settings: PartySettings{NominationRule: NominationRuleMajority, NominationQuorum: 0.75},
+			votes:    map[string]string{"host": VoteYay, "a": VoteAbstain, "b": VoteAbstain},
+			weights:  four,
+			want:     true,
+		},
+		{
+			name:     "abstentions are not cast votes",
+			settings: PartySettings{NominationRule: NominationRuleUnanimous},
+			votes:    map[string]string{"host": VoteYay, "a": VoteAbstain, "b": VoteAbstain},
+			weights:  four,
+			want:     true,
+		},
+		{
+			name:     "only abstentions decide nothing",
+			settings: PartySettings{NominationRule: NominationRuleMajority},
+			votes:    map[string]string{"host": VoteAbstain, "a": VoteAbstain},
+			weights:  four,
+			want:     false,
+		},
+		{
+			name:     "weighted yay outweighs two nays",
+			settings: PartySettings{NominationRule: NominationRuleMajority},
+			votes:    map[string]string{"host": VoteYay, "a": VoteNay, "b": VoteNay},
+			weights:  map[string]float64{"host": 3, "a": 1, "b": 1, "c": 1},
+			want:     true,
+		},
+		{
+			name:     "weighted quorum counts weight, not heads",
+			settings: PartySettings{NominationRule: NominationRuleMajority, NominationQuorum: 0.5},
+			votes:    map[string]string{"a": VoteYay, "b": VoteYay},
+			weights:  map[string]float64{"host": 5, "a": 1, "b": 1, "c": 1},
+			want:     false,
+		},
+		{
+			name:     "weighted fixed rule counts yay weight",
+			settings: PartySettings{NominationRule: NominationRuleFixed, NominationYayCount: 2},
+			votes:    map[string]string{"a": VoteYay},
+			weights:  map[string]float64{"host": 1, "a": 2, "b": 1},
+			want:     true,
+		},
+		{
+			name:     "host veto rejects on a host nay",
+			settings: PartySettings{NominationRule: NominationRuleMajority, HostVeto: true},
+			votes:    map[string]string{"host": VoteNay, "a": VoteYay, "b": VoteYay, "c": VoteYay},
+			weights:  four,
+			want:     false,
+		},
+		{
+			name:     "host nay without host veto is an ordinary vote",
+			settings: PartySettings{NominationRule: NominationRuleMajority},
+			votes:    map[string]string{"host": VoteNay, "a": VoteYay, "b": VoteYay, "c": VoteYay},
+			weights:  four,
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.settings.NominationPasses(tt.votes, "host", tt.weights); got != tt.want {
+				t.Errorf("NominationPasses() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNominationTimeoutResolvesFromStoredState(t *testing.T) {
+	store := newMemoryStore()
+	party := newTestParty(t, store, PhaseNominating, PartySettings{NominationRule: NominationRuleMajority})
+
+	// A vote whose deadline passed while no instance had a timer for it
+	deadline := time.Now().Add(-time.Second)
+	party.CurrentNomination = &NominationVote{
+		Movie:       Movie{ID: "605", Title: "The Matrix Revolutions"},
+		Voters:      map[string]string{"host": VoteYay},
+		SuggestedBy: "host",
+		Deadline:    &deadline,
+	}
+	if err := store.SaveParty(context.Background(), party); err != nil {
+		t.Fatalf("SaveParty: %v", err)
+	}
+
+	if next, ok := party.NextDeadline(); !ok || !next.Equal(deadline) {
+		t.Fatalf("NextDeadline() = %v, %v, want the vote deadline", next, ok)
+	}
+
+	service := NewService(store, nil)
+	updated, err := service.ResolveDeadlines(context.Background(), "party-1")
+	if err != nil {
+		t.Fatalf("ResolveDeadlines: %v", err)
+	}
+	if updated == nil || updated.CurrentNomination != nil {
+		t.Fatalf("party = %+v, want the nomination vote closed", updated)
+	}
+	if len(updated.NominationPool) != 3 || updated.NominationPool[2].ID != "605" {
+		t.Errorf("nomination pool = %+v, want 605 added", updated.NominationPool)
+	}
+	if _, ok := updated.NextDeadline(); ok {
+		t.Error("party still has a deadline after it was applied")
+	}
+
+	// Another instance applying the same deadline finds nothing due
+	again, err := NewService(store, nil).ResolveDeadlines(context.Background(), "party-1")
+	if err != nil || again != nil {
+		t.Errorf("second ResolveDeadlines = %+v, %v, want nil, nil", again, err)
+	}
+}
@@ -15,14 +15,39 @@ const (
 	MessageTypePartyUpdate         = "party_update"
 	MessageTypeUserJoined          = "user_joined"
 	MessageTypeUserLeft            = "user_left"
+	MessageTypeUpdateSettings      = "update_settings"
+	MessageTypeSetVoterWeight      = "set_voter_weight"
+	MessageTypeStartNomination     = "start_nomination"
 	MessageTypeSuggestMovie        = "suggest_movie"
 	MessageTypeVoteNomination      = "vote_nomination"
+	MessageTypeRetractVote         = "retract_vote"
+	MessageTypeCloseVoting         = "close_voting"
+	MessageTypeVetoMovie           = "veto_movie"
+	MessageTypeMovieVetoed         = "movie_vetoed"
 	MessageTypeFinalizeNominations = "finalize_nominations"
+	MessageTypeSubmitApprovals     = "submit_approvals"
+	MessageTypeCloseApproval       = "close_approval"
 	MessageTypeSubmitRanking       = "submit_ranking"
+	MessageTypeCloseRanking        = "close_ranking"
+	MessageTypeVoteRunoff          = "vote_runoff"
+	MessageTypeDecideRunoff        = "decide_runoff"
+	MessageTypeRematch             = "rematch"
 	MessageTypeSearchMovies        = "search_movies"
 	MessageTypeSearchResults       = "search_results"
+	MessageTypeError               = "error"
+	MessageTypeServerRestarting    = "server_restarting"
 )
 
+// UpdateSettingsPayload replaces the party settings. Unset fields take
+// their defaults.
+type UpdateSettingsPayload = PartySettings
+
+// SetVoterWeightPayload sets how much a participant's votes count
+type SetVoterWeightPayload struct {
+	UserID string  `json:"user_id"`
+	Weight float64 `json:"weight"`
+}
+
 // SuggestMoviePayload represents a movie suggestion payload
 type SuggestMoviePayload struct {
 	TMDBID string `json:"tmdb_id"`
@@ -30,23 +55,56 @@ type SuggestMoviePayload struct {
 
 // VotePayload represents a nomination vote payload
 type VotePayload struct {
-	Vote string `json:"vote"` // "yay" or "nay"
+	Vote string `json:"vote"` // "yay", "nay" or "abstain"
+}
+
+// VetoPayload uses a veto on a nominated movie
+type VetoPayload struct {
+	MovieID string `json:"movie_id"`
+}
+
+// MovieVetoedPayload announces a veto to the party
+type MovieVetoedPayload struct {
+	Veto Veto `json:"veto"`
 }
 
 // SearchMoviesPayload represents a movie search request
 type SearchMoviesPayload struct {
 	Query string `json:"query"`
+	SearchOptions
 }
 
 // SearchResultsPayload represents movie search results
 type SearchResultsPayload struct {
-	Query  string  `json:"query"`
-	Movies []Movie `json:"movies"`
+	Query        string  `json:"query"`
+	Movies       []Movie `json:"movies"`
+	Page         int     `json:"page"`
+	TotalResults int     `json:"total_results"`
+	TotalPages   int     `json:"total_pages"`
+}
+
+// SubmitApprovalsPayload lists the movies a participant approves of in the
+// approval round
+type SubmitApprovalsPayload struct {
+	MovieIDs []string `json:"movie_ids,omitempty"` // Empty approves none of the movies
 }
 
 // SubmitRankingPayload represents a ranking submission
 type SubmitRankingPayload struct {
-	Ranks []string `json:"ranks"` // Array of movie IDs in ranked order
+	Ranks     []string `json:"ranks"`                // Array of movie IDs in ranked order
+	NoOpinion []string `json:"no_opinion,omitempty"` // Movies deliberately left unranked
+}
+
+// RunoffVotePayload picks a movie in a runoff, either as a vote or, for
+// decide_runoff, as the host's final choice
+type RunoffVotePayload struct {
+	MovieID string `json:"movie_id"`
+}
+
+// RematchPayload starts a new round from a finished party
+type RematchPayload struct {
+	Phase         string `json:"phase"`                     // "lobby" or "nominating"
+	CarryOverPool bool   `json:"carry_over_pool,omitempty"` // Keep the nominated movies except the winners
 }
 
 // PartyUpdatePayload represents a party state update
@@ -54,9 +112,18 @@ type PartyUpdatePayload struct {
 	Party *Party `json:"party"`
 }
 
-// ErrorPayload represents an error message
+// ErrorPayload represents an error message. Code is one of the stable Code*
+// constants; Message is human-readable and may change.
 type ErrorPayload struct {
-	Error string `json:"error"`
+	Code    string                 `json:"code"`
+	Message string                 `json:"message"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// ServerRestartingPayload tells clients the server is shutting down and when
+// to reconnect. Delays are spread out so clients do not all reconnect at once.
+type ServerRestartingPayload struct {
+	ReconnectAfterMs int `json:"reconnect_after_ms"`
 }
 
 // CreateMessage creates a new message with the given type and payload
@@ -2,18 +2,25 @@ package party
 
 import (
 	"fmt"
-	"log"
+	"log/slog"
+	"math"
+
+	"github.com/reelchoice/backend/internal/metrics"
 )
 
 // CalculateWinner implements Ranked-Choice Voting (RCV) to determine the winning movie
 // RCV works by eliminating movies with the fewest first-choice votes iteratively
-// until one movie has a majority (>50%) of the remaining votes
-func CalculateWinner(pool []Movie, submissions map[string][]string) (*Movie, error) {
+// until one movie has a majority (>50%) of the remaining votes. Ballots whose
+// ranked movies have all been eliminated are exhausted and no longer count
+// toward the majority. Each ballot counts with its weight, so tallies can be
+// fractional. If every remaining movie has the same number of votes,
+// CalculateWinner returns a *TieError listing them.
+func CalculateWinner(pool []Movie, ballots []Ballot) (*Movie, error) {
 	if len(pool) == 0 {
 		return nil, fmt.Errorf("no movies in nomination pool")
 	}
 
-	if len(submissions) == 0 {
+	if len(ballots) == 0 {
 		return nil, fmt.Errorf("no voting submissions received")
 	}
 
@@ -29,10 +36,10 @@ func CalculateWinner(pool []Movie, submissions map[string][]string) (*Movie, err
 	}
 
 	// Validate all submissions contain only valid movie IDs
-	for userID, ranking := range submissions {
-		for _, movieID := range ranking {
+	for _, ballot := range ballots {
+		for _, movieID := range ballot.Ranks {
 			if _, exists := movieMap[movieID]; !exists {
-				log.Printf("Warning: User %s voted for invalid movie ID %s", userID, movieID)
+				slog.Warn("Ballot contains a movie outside the nomination pool", "user_id", ballot.UserID)
 			}
 		}
 	}
@@ -43,82 +50,136 @@ func CalculateWinner(pool []Movie, submissions map[string][]string) (*Movie, err
 		activeMovies[movie.ID] = true
 	}
 
-	totalVoters := len(submissions)
-	majorityThreshold := totalVoters/2 + 1
+	totalVoters := len(ballots)
+	totalWeight := 0.0
+	for _, ballot := range ballots {
+		totalWeight += ballot.weight()
+	}
+
+	// Only aggregate progress is logged; vote counts would reveal ballot contents
+	slog.Debug("Starting RCV calculation", "movies", len(pool), "ballots", totalVoters)
 
-	log.Printf("Starting RCV calculation with %d movies and %d voters (majority threshold: %d)",
-		len(pool), totalVoters, majorityThreshold)
+	// Vote counts of earlier rounds, used to break ties for elimination
+	var history []map[string]float64
 
 	// Run RCV rounds until we have a winner
 	round := 1
+	defer func() { metrics.RCVRounds.Observe(float64(round)) }()
 	for len(activeMovies) > 1 {
-		log.Printf("RCV Round %d: %d movies remaining", round, len(activeMovies))
 
 		// Count first-choice votes for each active movie
-		voteCounts := make(map[string]int)
+		voteCounts := make(map[string]float64)
 		for _, movie := range pool {
 			if activeMovies[movie.ID] {
 				voteCounts[movie.ID] = 0
 			}
 		}
 
-		// For each submission, find the highest-ranked active movie
-		for userID, ranking := range submissions {
-			firstChoice := getFirstActiveChoice(ranking, activeMovies)
+		// For each ballot, find the highest-ranked active movie
+		continuingBallots := 0
+		continuingWeight := 0.0
+		for _, ballot := range ballots {
+			firstChoice := getFirstActiveChoice(ballot.Ranks, activeMovies)
 			if firstChoice != "" {
-				voteCounts[firstChoice]++
-			} else {
-				log.Printf("Warning: User %s has no valid choices remaining", userID)
+				voteCounts[firstChoice] += ballot.weight()
+				continuingBallots++
+				continuingWeight += ballot.weight()
 			}
 		}
+		slog.Debug("RCV round", "round", round, "movies_remaining", len(activeMovies),
+			"continuing_ballots", continuingBallots, "exhausted_ballots", totalVoters-continuingBallots)
 
-		// Check if any movie has a majority
+		// Check if any movie has a majority of the continuing ballots
 		for movieID, votes := range voteCounts {
-			if votes >= majorityThreshold {
+			if votes*2 > continuingWeight+thresholdEpsilon {
 				winner := movieMap[movieID]
-				log.Printf("RCV Winner: %s with %d votes (%.1f%%)",
-					winner.Title, votes, float64(votes)/float64(totalVoters)*100)
+				slog.Debug("RCV winner by majority", "movie_id", winner.ID, "round", round)
 				return &winner, nil
 			}
 		}
 
-		// No majority found, eliminate the movie with the fewest votes
-		minVotes := totalVoters + 1
-		var movieToEliminate string
-
-		for movieID, votes := range voteCounts {
-			log.Printf("  %s: %d votes", movieMap[movieID].Title, votes)
-			if votes < minVotes {
-				minVotes = votes
-				movieToEliminate = movieID
+		// No majority found. If every remaining movie has the same number of
+		// votes, there is no fair way to eliminate one.
+		minVotes, maxVotes := totalWeight+1, -1.0
+		for _, votes := range voteCounts {
+			minVotes = min(minVotes, votes)
+			maxVotes = max(maxVotes, votes)
+		}
+		if sameTally(minVotes, maxVotes) {
+			tie := &TieError{}
+			for _, movie := range pool {
+				if activeMovies[movie.ID] {
+					tie.Movies = append(tie.Movies, movie)
+				}
 			}
+			slog.Debug("RCV ended in a tie", "movies", len(tie.Movies), "round", round)
+			return nil, tie
 		}
 
-		if movieToEliminate == "" {
-			// This shouldn't happen, but safeguard against infinite loop
-			break
-		}
+		// Eliminate the movie with the fewest votes
+		movieToEliminate := chooseElimination(pool, voteCounts, minVotes, history)
+		history = append(history, voteCounts)
 
-		// Eliminate the movie with fewest votes
 		eliminatedMovie := movieMap[movieToEliminate]
 		delete(activeMovies, movieToEliminate)
-		log.Printf("  Eliminated: %s with %d votes", eliminatedMovie.Title, minVotes)
+		slog.Debug("RCV eliminated movie", "movie_id", eliminatedMovie.ID, "round", round)
 
 		round++
 	}
 
-	// If we're down to one movie, it wins
-	if len(activeMovies) == 1 {
-		for movieID := range activeMovies {
-			winner := movieMap[movieID]
-			log.Printf("RCV Winner by elimination: %s", winner.Title)
-			return &winner, nil
+	// We're down to one movie, so it wins
+	for movieID := range activeMovies {
+		winner := movieMap[movieID]
+		slog.Debug("RCV winner by elimination", "movie_id", winner.ID, "round", round)
+		return &winner, nil
+	}
+
+	return nil, fmt.Errorf("RCV calculation ended without a winner")
+}
+
+// TieError reports movies that are exactly tied with no way to eliminate one
+type TieError struct {
+	Movies []Movie
+}
+
+// Error implements the error interface
+func (e *TieError) Error() string {
+	return fmt.Sprintf("exact tie between %d movies", len(e.Movies))
+}
+
+// chooseElimination picks which of the movies with the fewest votes to
+// eliminate. Ties go against the movie with fewer votes in the most recent
+// earlier round that separates them, then against the latest nomination.
+func chooseElimination(pool []Movie, voteCounts map[string]float64, minVotes float64, history []map[string]float64) string {
+	var candidates []string
+	for _, movie := range pool {
+		if votes, active := voteCounts[movie.ID]; active && sameTally(votes, minVotes) {
+			candidates = append(candidates, movie.ID)
+		}
+	}
+
+	for i := len(history) - 1; i >= 0 && len(candidates) > 1; i-- {
+		fewest := history[i][candidates[0]]
+		for _, movieID := range candidates[1:] {
+			fewest = min(fewest, history[i][movieID])
+		}
+
+		var remaining []string
+		for _, movieID := range candidates {
+			if sameTally(history[i][movieID], fewest) {
+				remaining = append(remaining, movieID)
+			}
 		}
+		candidates = remaining
 	}
 
-	// This shouldn't happen, but return first movie as fallback
-	log.Printf("Warning: RCV algorithm inconclusive, returning first movie")
-	return &pool[0], nil
+	return candidates[len(candidates)-1]
+}
+
+// sameTally reports whether two weighted vote counts are equal, allowing for
+// floating point error
+func sameTally(a, b float64) bool {
+	return math.Abs(a-b) < thresholdEpsilon
 }
 
 // getFirstActiveChoice returns the ID of the highest-ranked movie that's still active
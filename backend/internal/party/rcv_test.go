@@ -0,0 +1,128 @@
+package party
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestCalculateWinner(t *testing.T) {
+	tests := []struct {
+		name    string
+		pool    []Movie
+		ballots []Ballot
+		want    string   // Winning movie ID
+		wantTie []string // Tied movie IDs when no winner is expected
+	}{
+		{
+			name:    "first round majority",
+			pool:    movies("A", "B"),
+			ballots: concat(repeat(3, "A"), repeat(2, "B")),
+			want:    "A",
+		},
+		{
+			// A's 3 outweighs the two ballots for B
+			name: "weighted majority",
+			pool: movies("A", "B"),
+			ballots: []Ballot{
+				{UserID: "a", Ranks: []string{"A"}, Weight: 3},
+				{UserID: "b", Ranks: []string{"B"}},
+				{UserID: "c", Ranks: []string{"B"}},
+			},
+			want: "A",
+		},
+		{
+			// A: 0.5+0.5 against B: 0.75
+			name: "fractional weights",
+			pool: movies("A", "B"),
+			ballots: []Ballot{
+				{UserID: "a", Ranks: []string{"A"}, Weight: 0.5},
+				{UserID: "b", Ranks: []string{"A"}, Weight: 0.5},
+				{UserID: "c", Ranks: []string{"B"}, Weight: 0.75},
+			},
+			want: "A",
+		},
+		{
+			// D's ballot moves to B, tying B and C. B had fewer votes in
+			// the first round, so B goes and its ballots carry C.
+			name:    "elimination ties use earlier rounds",
+			pool:    movies("A", "B", "C", "D"),
+			ballots: concat(repeat(4, "A"), repeat(2, "B", "C"), repeat(3, "C", "A"), repeat(1, "D", "B")),
+			want:    "C",
+		},
+		{
+			// Once C goes, its ballots exhaust and A holds 3 of the 5
+			// continuing votes
+			name:    "exhausted ballots leave the majority",
+			pool:    movies("A", "B", "C"),
+			ballots: concat(repeat(3, "A"), repeat(2, "B"), repeat(1, "C")),
+			want:    "A",
+		},
+		{
+			// B goes first and its ballot exhausts rather than helping A
+			name:    "exhausted ballots transfer nothing",
+			pool:    movies("A", "B", "C"),
+			ballots: concat(repeat(2, "A"), repeat(1, "B"), repeat(2, "C", "B")),
+			wantTie: []string{"A", "C"},
+		},
+		{
+			name: "weighted tie",
+			pool: movies("A", "B"),
+			ballots: []Ballot{
+				{UserID: "a", Ranks: []string{"A"}, Weight: 2},
+				{UserID: "b", Ranks: []string{"B"}},
+				{UserID: "c", Ranks: []string{"B"}},
+			},
+			wantTie: []string{"A", "B"},
+		},
+		{
+			// Three ballots of 0.1 sum to slightly more than 0.3
+			name: "fractional tie within rounding error",
+			pool: movies("A", "B"),
+			ballots: []Ballot{
+				{UserID: "a", Ranks: []string{"A"}, Weight: 0.1},
+				{UserID: "b", Ranks: []string{"A"}, Weight: 0.1},
+				{UserID: "c", Ranks: []string{"A"}, Weight: 0.1},
+				{UserID: "d", Ranks: []string{"B"}, Weight: 0.3},
+			},
+			wantTie: []string{"A", "B"},
+		},
+		{
+			name:    "single movie wins",
+			pool:    movies("A"),
+			ballots: repeat(1, "A"),
+			want:    "A",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			winner, err := CalculateWinner(tt.pool, tt.ballots)
+			if tt.wantTie != nil {
+				var tie *TieError
+				if !errors.As(err, &tie) {
+					t.Fatalf("CalculateWinner = %v, %v, want a tie", winner, err)
+				}
+				if got := movieIDs(tie.Movies); !slices.Equal(got, tt.wantTie) {
+					t.Errorf("tied movies = %v, want %v", got, tt.wantTie)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CalculateWinner: %v", err)
+			}
+			if winner.ID != tt.want {
+				t.Errorf("winner = %s, want %s", winner.ID, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateWinnerErrors(t *testing.T) {
+	if _, err := CalculateWinner(nil, repeat(1, "A")); err == nil {
+		t.Error("empty pool returned nil, want an error")
+	}
+	if _, err := CalculateWinner(movies("A", "B"), nil); err == nil {
+		t.Error("no ballots returned nil, want an error")
+	}
+}
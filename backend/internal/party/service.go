@@ -2,9 +2,17 @@ package party
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"sort"
+	"sync"
 	"time"
+
+	"github.com/google/uuid"
+	"github.com/reelchoice/backend/internal/logging"
+	"github.com/reelchoice/backend/internal/metrics"
+	"github.com/reelchoice/backend/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // RedisStore interface for party operations
@@ -19,7 +27,7 @@ type RedisStore interface {
 
 // TMDBClient interface for movie operations
 type TMDBClient interface {
-	SearchMovies(ctx context.Context, query string) ([]Movie, error)
+	SearchMovies(ctx context.Context, query string, opts SearchOptions) (*SearchResult, error)
 	GetMovieDetails(ctx context.Context, tmdbID string) (*Movie, error)
 }
 
@@ -27,6 +35,11 @@ type TMDBClient interface {
 type Service struct {
 	redis RedisStore
 	tmdb  TMDBClient
+
+	// Operations that write party state, waited for by Drain
+	inflight   sync.WaitGroup
+	drainMutex sync.Mutex
+	draining   bool
 }
 
 // NewService creates a new party service
@@ -37,10 +50,58 @@ func NewService(redis RedisStore, tmdb TMDBClient) *Service {
 	}
 }
 
-// WithLock executes a function while holding a distributed lock for the party
-func (s *Service) WithLock(ctx context.Context, partyID string, fn func(ctx context.Context) error) error {
+// Drain stops the service from starting operations that write party state
+// and waits for those in flight to finish, so shutdown does not leave a
+// party half-written. Operations started afterwards fail with
+// ErrShuttingDown. Drain returns ctx's error if it expires first.
+func (s *Service) Drain(ctx context.Context) error {
+	s.drainMutex.Lock()
+	s.draining = true
+	s.drainMutex.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.inflight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// begin registers an operation that writes party state. The returned
+// function must be called when the operation finishes.
+func (s *Service) begin() (func(), error) {
+	s.drainMutex.Lock()
+	defer s.drainMutex.Unlock()
+
+	if s.draining {
+		return nil, ErrShuttingDown
+	}
+	s.inflight.Add(1)
+	return s.inflight.Done, nil
+}
+
+// WithLock executes a function while holding a distributed lock for the
+// party. The context passed to fn logs with the party ID. Errors are
+// recorded on the span in ctx.
+func (s *Service) WithLock(ctx context.Context, partyID string, fn func(ctx context.Context) error) (err error) {
 	const lockDuration = 5 * time.Second
 
+	defer func() { tracing.RecordError(ctx, err) }()
+
+	done, err := s.begin()
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	ctx = logging.With(ctx, logging.KeyPartyID, partyID)
+
 	// Attempt to acquire lock
 	acquired, err := s.redis.AcquireLock(ctx, partyID, lockDuration)
 	if err != nil {
@@ -48,13 +109,15 @@ func (s *Service) WithLock(ctx context.Context, partyID string, fn func(ctx cont
 	}
 
 	if !acquired {
-		return fmt.Errorf("party is currently being modified by another request")
+		metrics.LockContention.Inc()
+		return ErrPartyBusy
 	}
+	trace.SpanFromContext(ctx).AddEvent("party lock acquired")
 
-	// Ensure lock is always released
+	// Ensure lock is always released, even if the request was canceled
 	defer func() {
-		if releaseErr := s.redis.ReleaseLock(ctx, partyID); releaseErr != nil {
-			log.Printf("Failed to release lock for party %s: %v", partyID, releaseErr)
+		if releaseErr := s.redis.ReleaseLock(context.WithoutCancel(ctx), partyID); releaseErr != nil {
+			logging.FromContext(ctx).Error("Failed to release party lock", "error", releaseErr)
 		}
 	}()
 
@@ -62,47 +125,321 @@ func (s *Service) WithLock(ctx context.Context, partyID string, fn func(ctx cont
 }
 
 // SearchMovies searches for movies using TMDB API
-func (s *Service) SearchMovies(ctx context.Context, query string) ([]Movie, error) {
-	return s.tmdb.SearchMovies(ctx, query)
+func (s *Service) SearchMovies(ctx context.Context, query string, opts SearchOptions) (*SearchResult, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.SearchMovies")
+	defer span.End()
+
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return s.tmdb.SearchMovies(ctx, query, opts)
+}
+
+// CreateParty creates a party in the lobby phase with the creator as host
+func (s *Service) CreateParty(ctx context.Context, name string, settings PartySettings) (*Party, *Participant, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.CreateParty")
+	defer span.End()
+
+	settings.Normalize()
+	if err := settings.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	newParty := &Party{
+		ID:           uuid.New().String(),
+		Name:         name,
+		Participants: make(map[string]*Participant),
+		Phase:        PhaseLobby,
+		CreatedAt:    time.Now(),
+		Settings:     settings,
+	}
+
+	// Add creator as host
+	hostID := uuid.New().String()
+	newParty.AddParticipant(hostID, settings.HostDisplayName, true)
+
+	done, err := s.begin()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer done()
+
+	if err := s.redis.SaveParty(ctx, newParty); err != nil {
+		return nil, nil, fmt.Errorf("failed to save party: %w", err)
+	}
+
+	return newParty, newParty.GetParticipant(hostID), nil
+}
+
+// JoinParty adds a new participant to a party
+func (s *Service) JoinParty(ctx context.Context, partyID, username string) (*Party, *Participant, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.JoinParty", tracing.KeyPartyID.String(partyID))
+	defer span.End()
+
+	if username == "" {
+		return nil, nil, ErrInvalidRequest.WithMessage("username cannot be empty")
+	}
+	if len(username) > MaxUsernameLength {
+		return nil, nil, ErrInvalidRequest.WithMessage("username cannot be longer than %d characters", MaxUsernameLength)
+	}
+
+	var updatedParty *Party
+	var participant *Participant
+
+	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
+		// Get current party state
+		party, err := s.redis.GetParty(ctx, partyID)
+		if err != nil {
+			return fmt.Errorf("failed to get party: %w", err)
+		}
+		if party == nil {
+			return ErrPartyNotFound
+		}
+
+		if party.UsernameTaken(username, "") {
+			return ErrUsernameTaken
+		}
+
+		// Enforce the participant limit
+		if limit := party.Settings.MaxParticipants; limit > 0 && party.ParticipantCount() >= limit {
+			return ErrPartyFull.WithDetail("max_participants", limit)
+		}
+
+		userID := uuid.New().String()
+		party.AddParticipant(userID, username, false)
+
+		// Save updated party
+		if err := s.redis.SaveParty(ctx, party); err != nil {
+			return fmt.Errorf("failed to save party: %w", err)
+		}
+
+		updatedParty = party
+		participant = party.GetParticipant(userID)
+		return nil
+	})
+
+	return updatedParty, participant, err
+}
+
+// UpdateSettings replaces the party settings while the party is in the lobby
+func (s *Service) UpdateSettings(ctx context.Context, partyID, hostID string, settings PartySettings) (*Party, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.UpdateSettings", tracing.KeyPartyID.String(partyID), tracing.KeyUserID.String(hostID))
+	defer span.End()
+
+	settings.Normalize()
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	var updatedParty *Party
+
+	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
+		// Get current party state
+		party, err := s.redis.GetParty(ctx, partyID)
+		if err != nil {
+			return fmt.Errorf("failed to get party: %w", err)
+		}
+		if party == nil {
+			return ErrPartyNotFound
+		}
+
+		// Validate host permissions
+		if !party.IsHost(hostID) {
+			return ErrNotHost.WithMessage("only the host can change party settings")
+		}
+
+		// Validate party phase
+		if party.Phase != PhaseLobby {
+			return ErrWrongPhase.WithMessage("settings can only be changed in the lobby").WithDetail("phase", party.Phase)
+		}
+
+		// A lower participant limit cannot remove people who already joined
+		if settings.MaxParticipants > 0 && settings.MaxParticipants < party.ParticipantCount() {
+			return ErrInvalidSettings.WithMessage("max_participants cannot be lower than the %d participants already in the party", party.ParticipantCount()).
+				WithDetail("field", "max_participants")
+		}
+
+		// Rename the host
+		if party.UsernameTaken(settings.HostDisplayName, hostID) {
+			return ErrUsernameTaken.WithDetail("field", "host_display_name")
+		}
+		party.GetParticipant(hostID).Username = settings.HostDisplayName
+
+		party.Settings = settings
+
+		// Save updated party
+		if err := s.redis.SaveParty(ctx, party); err != nil {
+			return fmt.Errorf("failed to save party: %w", err)
+		}
+
+		updatedParty = party
+		return nil
+	})
+
+	return updatedParty, err
+}
+
+// SetVoterWeight sets how much a participant's votes count while the party
+// is in the lobby
+func (s *Service) SetVoterWeight(ctx context.Context, partyID, hostID, userID string, weight float64) (*Party, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.SetVoterWeight", tracing.KeyPartyID.String(partyID), tracing.KeyUserID.String(hostID))
+	defer span.End()
+
+	if weight <= 0 || weight > MaxVoterWeight {
+		return nil, ErrInvalidRequest.WithMessage("weight must be greater than 0 and at most %g", MaxVoterWeight).WithDetail("field", "weight")
+	}
+
+	var updatedParty *Party
+
+	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
+		// Get current party state
+		party, err := s.redis.GetParty(ctx, partyID)
+		if err != nil {
+			return fmt.Errorf("failed to get party: %w", err)
+		}
+		if party == nil {
+			return ErrPartyNotFound
+		}
+
+		// Validate host permissions
+		if !party.IsHost(hostID) {
+			return ErrNotHost.WithMessage("only the host can set voter weights")
+		}
+
+		// Validate party phase
+		if party.Phase != PhaseLobby {
+			return ErrWrongPhase.WithMessage("voter weights can only be changed in the lobby").WithDetail("phase", party.Phase)
+		}
+
+		participant := party.GetParticipant(userID)
+		if participant == nil {
+			return ErrParticipantNotFound.WithDetail("user_id", userID)
+		}
+		participant.Weight = weight
+
+		// Save updated party
+		if err := s.redis.SaveParty(ctx, party); err != nil {
+			return fmt.Errorf("failed to save party: %w", err)
+		}
+
+		updatedParty = party
+		return nil
+	})
+
+	return updatedParty, err
+}
+
+// StartNomination moves party from lobby to nominating phase
+func (s *Service) StartNomination(ctx context.Context, partyID, hostID string) (*Party, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.StartNomination", tracing.KeyPartyID.String(partyID), tracing.KeyUserID.String(hostID))
+	defer span.End()
+
+	var updatedParty *Party
+
+	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
+		// Get current party state
+		party, err := s.redis.GetParty(ctx, partyID)
+		if err != nil {
+			return fmt.Errorf("failed to get party: %w", err)
+		}
+		if party == nil {
+			return ErrPartyNotFound
+		}
+
+		// Validate host permissions
+		if !party.IsHost(hostID) {
+			return ErrNotHost.WithMessage("only the host can start nomination phase")
+		}
+
+		// Validate party phase
+		if party.Phase != PhaseLobby {
+			return ErrWrongPhase.WithMessage("party must be in lobby phase to start nominations").WithDetail("phase", party.Phase)
+		}
+
+		// Change phase to nominating and initialize nomination fields
+		party.Phase = PhaseNominating
+		party.CurrentNomination = nil
+		party.NominationPool = make([]Movie, 0)
+
+		// Save updated party
+		if err := s.redis.SaveParty(ctx, party); err != nil {
+			return fmt.Errorf("failed to save party: %w", err)
+		}
+
+		updatedParty = party
+		return nil
+	})
+
+	return updatedParty, err
 }
 
 // SuggestMovie adds a movie nomination to a party
 func (s *Service) SuggestMovie(ctx context.Context, partyID, userID, tmdbID string) (*Party, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.SuggestMovie", tracing.KeyPartyID.String(partyID), tracing.KeyUserID.String(userID))
+	defer span.End()
+
+	// Get movie details before taking the lock: with retries and rate
+	// limiting, a metadata lookup can outlast the lock's expiry
+	movie, err := s.tmdb.GetMovieDetails(ctx, tmdbID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get movie details: %w", err)
+	}
+	if movie == nil {
+		return nil, ErrMovieNotFound.WithDetail("tmdb_id", tmdbID)
+	}
+
 	var updatedParty *Party
 
-	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
+	err = s.WithLock(ctx, partyID, func(ctx context.Context) error {
 		// Get current party state
 		party, err := s.redis.GetParty(ctx, partyID)
 		if err != nil {
 			return fmt.Errorf("failed to get party: %w", err)
 		}
 		if party == nil {
-			return fmt.Errorf("party not found")
+			return ErrPartyNotFound
 		}
 
 		// Validate party phase
 		if party.Phase != PhaseNominating {
-			return fmt.Errorf("nominations are not open")
+			return ErrWrongPhase.WithMessage("nominations are not open").WithDetail("phase", party.Phase)
 		}
 
 		// Check if there's already a nomination in progress
 		if party.CurrentNomination != nil {
-			return fmt.Errorf("another nomination is already in progress")
+			return ErrNominationInProgress
 		}
 
-		// Get movie details from TMDB
-		movie, err := s.tmdb.GetMovieDetails(ctx, tmdbID)
-		if err != nil {
-			return fmt.Errorf("failed to get movie details: %w", err)
+		// Enforce the per-participant suggestion limit
+		if limit := party.Settings.MaxSuggestionsPerUser; limit > 0 && party.SuggestionCounts[userID] >= limit {
+			return ErrSuggestionLimit.WithMessage("you can suggest at most %d movies", limit).WithDetail("limit", limit)
 		}
-		if movie == nil {
-			return fmt.Errorf("movie not found")
+
+		if party.SuggestionCounts == nil {
+			party.SuggestionCounts = make(map[string]int)
+		}
+		party.SuggestionCounts[userID]++
+
+		// Suggestions skip the vote under the auto-accept rule
+		if party.Settings.nominationRule() == NominationRuleAutoAccept {
+			addToPool(party, *movie)
+			if err := s.redis.SaveParty(ctx, party); err != nil {
+				return fmt.Errorf("failed to save party: %w", err)
+			}
+
+			updatedParty = party
+			return nil
 		}
 
 		// Create new nomination
 		party.CurrentNomination = &NominationVote{
-			Movie:  *movie,
-			Voters: make(map[string]string),
+			Movie:       *movie,
+			Voters:      make(map[string]string),
+			SuggestedBy: userID,
+		}
+		if seconds := party.Settings.NominationVoteSeconds; seconds > 0 {
+			deadline := time.Now().Add(time.Duration(seconds) * time.Second)
+			party.CurrentNomination.Deadline = &deadline
 		}
 
 		// Save updated party
@@ -119,6 +456,9 @@ func (s *Service) SuggestMovie(ctx context.Context, partyID, userID, tmdbID stri
 
 // VoteNomination records a vote for a movie nomination
 func (s *Service) VoteNomination(ctx context.Context, partyID, userID, vote string) (*Party, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.VoteNomination", tracing.KeyPartyID.String(partyID), tracing.KeyUserID.String(userID))
+	defer span.End()
+
 	var updatedParty *Party
 
 	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
@@ -128,45 +468,37 @@ func (s *Service) VoteNomination(ctx context.Context, partyID, userID, vote stri
 			return fmt.Errorf("failed to get party: %w", err)
 		}
 		if party == nil {
-			return fmt.Errorf("party not found")
+			return ErrPartyNotFound
+		}
+
+		// Validate party phase
+		if party.Phase != PhaseNominating {
+			return ErrWrongPhase.WithMessage("nominations are not open").WithDetail("phase", party.Phase)
+		}
+
+		// Only participants may vote
+		if party.GetParticipant(userID) == nil {
+			return ErrNotParticipant.WithDetail("user_id", userID)
 		}
 
 		// Check if there's a nomination in progress
 		if party.CurrentNomination == nil {
-			return fmt.Errorf("no nomination in progress")
+			return ErrNoNomination
 		}
 
-		if vote != "yay" && vote != "nay" {
-			return fmt.Errorf("vote must be 'yay' or 'nay'")
+		if vote != VoteYay && vote != VoteNay && vote != VoteAbstain {
+			return ErrInvalidVote.WithDetail("vote", vote)
 		}
 
-		// Record the vote
+		// Record the vote, replacing any earlier vote
 		party.CurrentNomination.Voters[userID] = vote
 
-		// Check if voting is complete (all participants have voted)
-		totalParticipants := len(party.Participants)
-		totalVotes := len(party.CurrentNomination.Voters)
-
-		if totalVotes >= totalParticipants {
-			// Count votes
-			yayVotes := 0
-			for _, vote := range party.CurrentNomination.Voters {
-				if vote == "yay" {
-					yayVotes++
-				}
-			}
-
-			// Need majority to pass
-			if yayVotes > totalParticipants/2 {
-				// Add to nomination pool
-				if party.NominationPool == nil {
-					party.NominationPool = make([]Movie, 0)
-				}
-				party.NominationPool = append(party.NominationPool, party.CurrentNomination.Movie)
-			}
-
-			// Clear current nomination
-			party.CurrentNomination = nil
+		// Resolve the nomination straight away when the host vetoes it, or
+		// once all participants have voted unless the host closes voting
+		vetoed := party.Settings.HostVeto && vote == VoteNay && party.IsHost(userID)
+		allVoted := len(party.CurrentNomination.Voters) >= len(party.Participants)
+		if vetoed || (allVoted && !party.Settings.HostClosesVoting) {
+			resolveNomination(party)
 		}
 
 		// Save updated party
@@ -181,8 +513,11 @@ func (s *Service) VoteNomination(ctx context.Context, partyID, userID, vote stri
 	return updatedParty, err
 }
 
-// FinalizeNominations moves party from nominating to ranking phase
-func (s *Service) FinalizeNominations(ctx context.Context, partyID, hostID string) (*Party, error) {
+// RetractVote removes a participant's vote on the current nomination
+func (s *Service) RetractVote(ctx context.Context, partyID, userID string) (*Party, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.RetractVote", tracing.KeyPartyID.String(partyID), tracing.KeyUserID.String(userID))
+	defer span.End()
+
 	var updatedParty *Party
 
 	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
@@ -192,32 +527,28 @@ func (s *Service) FinalizeNominations(ctx context.Context, partyID, hostID strin
 			return fmt.Errorf("failed to get party: %w", err)
 		}
 		if party == nil {
-			return fmt.Errorf("party not found")
-		}
-
-		// Validate host permissions
-		if !party.IsHost(hostID) {
-			return fmt.Errorf("only the host can finalize nominations")
+			return ErrPartyNotFound
 		}
 
 		// Validate party phase
 		if party.Phase != PhaseNominating {
-			return fmt.Errorf("party is not in nominating phase")
+			return ErrWrongPhase.WithMessage("nominations are not open").WithDetail("phase", party.Phase)
 		}
 
-		// Check if there are any nominations
-		if len(party.NominationPool) == 0 {
-			return fmt.Errorf("no movies have been nominated")
+		// Only participants may vote
+		if party.GetParticipant(userID) == nil {
+			return ErrNotParticipant.WithDetail("user_id", userID)
 		}
 
-		// Move to ranking phase
-		party.Phase = PhaseRanking
-		party.CurrentNomination = nil // Clear any ongoing nomination
+		// Check if there's a nomination in progress
+		if party.CurrentNomination == nil {
+			return ErrNoNomination
+		}
 
-		// Initialize submissions map
-		if party.Submissions == nil {
-			party.Submissions = make(map[string][]string)
+		if _, voted := party.CurrentNomination.Voters[userID]; !voted {
+			return ErrInvalidVote.WithMessage("you have not voted on this nomination")
 		}
+		delete(party.CurrentNomination.Voters, userID)
 
 		// Save updated party
 		if err := s.redis.SaveParty(ctx, party); err != nil {
@@ -231,8 +562,11 @@ func (s *Service) FinalizeNominations(ctx context.Context, partyID, hostID strin
 	return updatedParty, err
 }
 
-// SubmitRanking handles final ranking submission and calculates results
-func (s *Service) SubmitRanking(ctx context.Context, partyID, userID string, rankings []string) (*Party, error) {
+// CloseVoting resolves the current nomination with the votes received
+func (s *Service) CloseVoting(ctx context.Context, partyID, hostID string) (*Party, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.CloseVoting", tracing.KeyPartyID.String(partyID), tracing.KeyUserID.String(hostID))
+	defer span.End()
+
 	var updatedParty *Party
 
 	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
@@ -242,49 +576,74 @@ func (s *Service) SubmitRanking(ctx context.Context, partyID, userID string, ran
 			return fmt.Errorf("failed to get party: %w", err)
 		}
 		if party == nil {
-			return fmt.Errorf("party not found")
+			return ErrPartyNotFound
 		}
 
-		// Validate party phase
-		if party.Phase != PhaseRanking {
-			return fmt.Errorf("ranking is not open")
+		// Validate host permissions
+		if !party.IsHost(hostID) {
+			return ErrNotHost.WithMessage("only the host can close voting")
 		}
 
-		// Validate rankings length matches nominated movies
-		if len(rankings) != len(party.NominationPool) {
-			return fmt.Errorf("ranking must include all %d nominated movies", len(party.NominationPool))
+		// Validate party phase
+		if party.Phase != PhaseNominating {
+			return ErrWrongPhase.WithMessage("nominations are not open").WithDetail("phase", party.Phase)
 		}
 
-		// Validate that all movie IDs in the ranking are valid
-		nominatedMovieIDs := make(map[string]bool)
-		for _, movie := range party.NominationPool {
-			nominatedMovieIDs[movie.ID] = true
+		// Check if there's a nomination in progress
+		if party.CurrentNomination == nil {
+			return ErrNoNomination
 		}
 
-		for _, movieID := range rankings {
-			if !nominatedMovieIDs[movieID] {
-				return fmt.Errorf("invalid movie ID in ranking: %s", movieID)
-			}
-		}
+		resolveNomination(party)
 
-		// Store user's ranking
-		if party.Submissions == nil {
-			party.Submissions = make(map[string][]string)
+		// Save updated party
+		if err := s.redis.SaveParty(ctx, party); err != nil {
+			return fmt.Errorf("failed to save party: %w", err)
 		}
-		party.Submissions[userID] = rankings
 
-		// Check if all participants have submitted rankings
-		allRankingsSubmitted := len(party.Submissions) == len(party.Participants)
+		updatedParty = party
+		return nil
+	})
 
-		if allRankingsSubmitted {
-			// Calculate final results using RCV
-			winner, err := CalculateWinner(party.NominationPool, party.Submissions)
-			if err != nil {
-				log.Printf("Error calculating RCV winner for party %s: %v", partyID, err)
-			} else {
-				party.Winner = winner
+	return updatedParty, err
+}
+
+// ResolveDeadlines applies any deadline of the party that has passed. It
+// returns nil if nothing was due, so callers can skip broadcasting.
+func (s *Service) ResolveDeadlines(ctx context.Context, partyID string) (*Party, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.ResolveDeadlines", tracing.KeyPartyID.String(partyID))
+	defer span.End()
+
+	var updatedParty *Party
+
+	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
+		// Get current party state
+		party, err := s.redis.GetParty(ctx, partyID)
+		if err != nil {
+			return fmt.Errorf("failed to get party: %w", err)
+		}
+		if party == nil {
+			return ErrPartyNotFound
+		}
+
+		now := time.Now()
+		switch {
+		case party.CurrentNomination != nil && party.CurrentNomination.Deadline != nil && !now.Before(*party.CurrentNomination.Deadline):
+			// Close the nomination vote with the votes received
+			logging.FromContext(ctx).Info("Nomination vote timed out")
+			resolveNomination(party)
+		case party.Phase == PhaseRanking && party.RankingDeadline != nil && !now.Before(*party.RankingDeadline):
+			// Close ranking with the ballots received
+			logging.FromContext(ctx).Info("Ranking timed out")
+			if err := finishRanking(ctx, party, ClosedByDeadline); err != nil {
+				return err
 			}
-			party.Phase = PhaseFinished
+		case party.Phase == PhaseRunoff && party.Runoff != nil && party.Runoff.Deadline != nil && !now.Before(*party.Runoff.Deadline):
+			// Decide the runoff with the votes received
+			logging.FromContext(ctx).Info("Runoff timed out")
+			resolveRunoff(party)
+		default:
+			return nil
 		}
 
 		// Save updated party
@@ -298,3 +657,808 @@ func (s *Service) SubmitRanking(ctx context.Context, partyID, userID string, ran
 
 	return updatedParty, err
 }
+
+// resolveNomination closes the current nomination, adding the movie to the
+// nomination pool if it passes the party's nomination rule
+func resolveNomination(party *Party) {
+	hostID := ""
+	if host := party.GetHost(); host != nil {
+		hostID = host.ID
+	}
+
+	if party.Settings.NominationPasses(party.CurrentNomination.Voters, hostID, party.VoterWeights()) {
+		addToPool(party, party.CurrentNomination.Movie)
+	}
+
+	// Clear current nomination
+	party.CurrentNomination = nil
+}
+
+// addToPool adds a movie to the nomination pool
+func addToPool(party *Party, movie Movie) {
+	if party.NominationPool == nil {
+		party.NominationPool = make([]Movie, 0)
+	}
+	party.NominationPool = append(party.NominationPool, movie)
+}
+
+// VetoMovie uses one of the participant's vetoes on a movie. During
+// nomination it blocks the nomination being voted on or removes a movie from
+// the nomination pool; during ranking it removes a movie from the pool and
+// from the ballots already submitted.
+func (s *Service) VetoMovie(ctx context.Context, partyID, userID, movieID string) (*Party, *Veto, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.VetoMovie", tracing.KeyPartyID.String(partyID), tracing.KeyUserID.String(userID))
+	defer span.End()
+
+	var updatedParty *Party
+	var veto *Veto
+
+	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
+		// Get current party state
+		party, err := s.redis.GetParty(ctx, partyID)
+		if err != nil {
+			return fmt.Errorf("failed to get party: %w", err)
+		}
+		if party == nil {
+			return ErrPartyNotFound
+		}
+
+		// Validate party phase
+		if party.Phase != PhaseNominating && party.Phase != PhaseRanking {
+			return ErrWrongPhase.WithMessage("vetoes can only be used during nomination or ranking").WithDetail("phase", party.Phase)
+		}
+
+		// Only participants have vetoes
+		if party.GetParticipant(userID) == nil {
+			return ErrNotParticipant.WithDetail("user_id", userID)
+		}
+
+		// Enforce the veto limit
+		if party.VetoesUsed[userID] >= party.Settings.VetoesPerUser {
+			return ErrNoVetoesLeft.WithDetail("vetoes_per_user", party.Settings.VetoesPerUser)
+		}
+
+		veto = &Veto{VetoedBy: userID, Phase: party.Phase, At: time.Now()}
+		switch {
+		case party.Phase == PhaseNominating && party.CurrentNomination != nil && party.CurrentNomination.Movie.ID == movieID:
+			// Block the nomination being voted on
+			veto.Movie = party.CurrentNomination.Movie
+			veto.Target = VetoTargetNomination
+			party.CurrentNomination = nil
+		default:
+			index := -1
+			for i, movie := range party.NominationPool {
+				if movie.ID == movieID {
+					index = i
+					break
+				}
+			}
+			if index == -1 {
+				return ErrMovieNotFound.WithMessage("movie %s is not nominated", movieID).WithDetail("movie_id", movieID)
+			}
+			if party.Phase == PhaseRanking && len(party.NominationPool) == 1 {
+				return ErrEmptyNominationPool.WithMessage("cannot veto the last nominated movie")
+			}
+
+			veto.Movie = party.NominationPool[index]
+			veto.Target = VetoTargetPool
+			party.NominationPool = append(party.NominationPool[:index], party.NominationPool[index+1:]...)
+			if party.Phase == PhaseRanking {
+				removeFromBallots(party, movieID)
+			}
+		}
+
+		if party.VetoesUsed == nil {
+			party.VetoesUsed = make(map[string]int)
+		}
+		party.VetoesUsed[userID]++
+		party.Vetoes = append(party.Vetoes, *veto)
+
+		// Save updated party
+		if err := s.redis.SaveParty(ctx, party); err != nil {
+			return fmt.Errorf("failed to save party: %w", err)
+		}
+
+		updatedParty = party
+		return nil
+	})
+
+	return updatedParty, veto, err
+}
+
+// removeFromBallots drops a vetoed movie from the submitted ballots.
+// Participants whose ballot ranked only that movie must submit again.
+func removeFromBallots(party *Party, movieID string) {
+	for userID, ranks := range party.Submissions {
+		party.Submissions[userID] = withoutMovie(ranks, movieID)
+		if len(party.Submissions[userID]) == 0 {
+			delete(party.Submissions, userID)
+			delete(party.NoOpinions, userID)
+		}
+	}
+	for userID, noOpinion := range party.NoOpinions {
+		party.NoOpinions[userID] = withoutMovie(noOpinion, movieID)
+	}
+}
+
+// withoutMovie returns the movie IDs other than movieID
+func withoutMovie(movieIDs []string, movieID string) []string {
+	remaining := make([]string, 0, len(movieIDs))
+	for _, id := range movieIDs {
+		if id != movieID {
+			remaining = append(remaining, id)
+		}
+	}
+	return remaining
+}
+
+// FinalizeNominations moves party from nominating to ranking phase
+func (s *Service) FinalizeNominations(ctx context.Context, partyID, hostID string) (*Party, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.FinalizeNominations", tracing.KeyPartyID.String(partyID), tracing.KeyUserID.String(hostID))
+	defer span.End()
+
+	var updatedParty *Party
+
+	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
+		// Get current party state
+		party, err := s.redis.GetParty(ctx, partyID)
+		if err != nil {
+			return fmt.Errorf("failed to get party: %w", err)
+		}
+		if party == nil {
+			return ErrPartyNotFound
+		}
+
+		// Validate host permissions
+		if !party.IsHost(hostID) {
+			return ErrNotHost.WithMessage("only the host can finalize nominations")
+		}
+
+		// Validate party phase
+		if party.Phase != PhaseNominating {
+			return ErrWrongPhase.WithMessage("party is not in nominating phase").WithDetail("phase", party.Phase)
+		}
+
+		// Check if there are any nominations
+		if len(party.NominationPool) == 0 {
+			return ErrEmptyNominationPool
+		}
+
+		party.CurrentNomination = nil // Clear any ongoing nomination
+
+		// Trim an oversized pool with an approval round before ranking
+		if limit := party.Settings.MaxPoolSize; limit > 0 && len(party.NominationPool) > limit {
+			party.Phase = PhaseApproval
+			party.Approvals = make(map[string][]string)
+		} else {
+			startRanking(party)
+		}
+
+		// Save updated party
+		if err := s.redis.SaveParty(ctx, party); err != nil {
+			return fmt.Errorf("failed to save party: %w", err)
+		}
+
+		updatedParty = party
+		return nil
+	})
+
+	return updatedParty, err
+}
+
+// startRanking moves the party to the ranking phase
+func startRanking(party *Party) {
+	party.Phase = PhaseRanking
+
+	// Initialize submissions map
+	if party.Submissions == nil {
+		party.Submissions = make(map[string][]string)
+	}
+
+	if seconds := party.Settings.RankingSeconds; seconds > 0 {
+		deadline := time.Now().Add(time.Duration(seconds) * time.Second)
+		party.RankingDeadline = &deadline
+	}
+}
+
+// SubmitApprovals records the movies a participant approves of in the
+// approval round. The round ends once every participant has submitted.
+func (s *Service) SubmitApprovals(ctx context.Context, partyID, userID string, movieIDs []string) (*Party, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.SubmitApprovals", tracing.KeyPartyID.String(partyID), tracing.KeyUserID.String(userID))
+	defer span.End()
+
+	var updatedParty *Party
+
+	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
+		// Get current party state
+		party, err := s.redis.GetParty(ctx, partyID)
+		if err != nil {
+			return fmt.Errorf("failed to get party: %w", err)
+		}
+		if party == nil {
+			return ErrPartyNotFound
+		}
+
+		// Validate party phase
+		if party.Phase != PhaseApproval {
+			return ErrWrongPhase.WithMessage("party is not in approval phase").WithDetail("phase", party.Phase)
+		}
+
+		// Only participants may approve movies
+		if party.GetParticipant(userID) == nil {
+			return ErrNotParticipant.WithDetail("user_id", userID)
+		}
+
+		// Validate the approved movies
+		nominatedMovieIDs := make(map[string]bool)
+		for _, movie := range party.NominationPool {
+			nominatedMovieIDs[movie.ID] = true
+		}
+		seen := make(map[string]bool)
+		for _, movieID := range movieIDs {
+			if !nominatedMovieIDs[movieID] {
+				return ErrInvalidVote.WithMessage("invalid movie ID in approvals: %s", movieID).WithDetail("movie_id", movieID)
+			}
+			if seen[movieID] {
+				return ErrInvalidVote.WithMessage("movie %s is approved more than once", movieID).WithDetail("movie_id", movieID)
+			}
+			seen[movieID] = true
+		}
+
+		// Record the approvals, replacing any earlier submission
+		if party.Approvals == nil {
+			party.Approvals = make(map[string][]string)
+		}
+		party.Approvals[userID] = append([]string{}, movieIDs...)
+
+		// Trim the pool once all participants have submitted
+		if len(party.Approvals) >= len(party.Participants) {
+			finishApproval(ctx, party)
+		}
+
+		// Save updated party
+		if err := s.redis.SaveParty(ctx, party); err != nil {
+			return fmt.Errorf("failed to save party: %w", err)
+		}
+
+		updatedParty = party
+		return nil
+	})
+
+	return updatedParty, err
+}
+
+// CloseApproval ends the approval round with the approvals received so far
+func (s *Service) CloseApproval(ctx context.Context, partyID, hostID string) (*Party, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.CloseApproval", tracing.KeyPartyID.String(partyID), tracing.KeyUserID.String(hostID))
+	defer span.End()
+
+	var updatedParty *Party
+
+	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
+		// Get current party state
+		party, err := s.redis.GetParty(ctx, partyID)
+		if err != nil {
+			return fmt.Errorf("failed to get party: %w", err)
+		}
+		if party == nil {
+			return ErrPartyNotFound
+		}
+
+		// Validate host permissions
+		if !party.IsHost(hostID) {
+			return ErrNotHost.WithMessage("only the host can close the approval round")
+		}
+
+		// Validate party phase
+		if party.Phase != PhaseApproval {
+			return ErrWrongPhase.WithMessage("party is not in approval phase").WithDetail("phase", party.Phase)
+		}
+
+		finishApproval(ctx, party)
+
+		// Save updated party
+		if err := s.redis.SaveParty(ctx, party); err != nil {
+			return fmt.Errorf("failed to save party: %w", err)
+		}
+
+		updatedParty = party
+		return nil
+	})
+
+	return updatedParty, err
+}
+
+// finishApproval keeps the max_pool_size movies with the most approvals,
+// weighted by voter weight, and starts ranking. Ties go to the movie
+// nominated first, and the kept movies stay in nomination order.
+func finishApproval(ctx context.Context, party *Party) {
+	counts := make(map[string]float64)
+	for userID, movieIDs := range party.Approvals {
+		weight := DefaultVoterWeight
+		if participant := party.GetParticipant(userID); participant != nil {
+			weight = participant.VoteWeight()
+		}
+		for _, movieID := range movieIDs {
+			counts[movieID] += weight
+		}
+	}
+
+	ordered := append([]Movie(nil), party.NominationPool...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return counts[ordered[i].ID] > counts[ordered[j].ID]+thresholdEpsilon
+	})
+
+	kept := make(map[string]bool)
+	for _, movie := range ordered[:min(party.Settings.MaxPoolSize, len(ordered))] {
+		kept[movie.ID] = true
+	}
+
+	pool := make([]Movie, 0, len(kept))
+	party.PreEliminated = make([]Movie, 0, len(party.NominationPool)-len(kept))
+	for _, movie := range party.NominationPool {
+		if kept[movie.ID] {
+			pool = append(pool, movie)
+		} else {
+			party.PreEliminated = append(party.PreEliminated, movie)
+		}
+	}
+	party.NominationPool = pool
+
+	logging.FromContext(ctx).Info("Approval round trimmed the nomination pool", "kept", len(pool), "eliminated", len(party.PreEliminated))
+	startRanking(party)
+}
+
+// SubmitRanking handles final ranking submission and calculates results.
+// noOpinion lists movies the participant explicitly declines to rank.
+func (s *Service) SubmitRanking(ctx context.Context, partyID, userID string, rankings, noOpinion []string) (*Party, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.SubmitRanking", tracing.KeyPartyID.String(partyID), tracing.KeyUserID.String(userID))
+	defer span.End()
+
+	var updatedParty *Party
+
+	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
+		// Get current party state
+		party, err := s.redis.GetParty(ctx, partyID)
+		if err != nil {
+			return fmt.Errorf("failed to get party: %w", err)
+		}
+		if party == nil {
+			return ErrPartyNotFound
+		}
+
+		// Validate party phase
+		if party.Phase != PhaseRanking {
+			return ErrWrongPhase.WithMessage("ranking is not open").WithDetail("phase", party.Phase)
+		}
+
+		// Only participants may submit a ballot
+		if party.GetParticipant(userID) == nil {
+			return ErrNotParticipant.WithDetail("user_id", userID)
+		}
+
+		if err := validateBallot(party.NominationPool, rankings, noOpinion, party.Settings.AllowPartialRanking); err != nil {
+			return err
+		}
+
+		// Store user's ranking
+		if party.Submissions == nil {
+			party.Submissions = make(map[string][]string)
+		}
+		party.Submissions[userID] = rankings
+
+		if len(noOpinion) > 0 {
+			if party.NoOpinions == nil {
+				party.NoOpinions = make(map[string][]string)
+			}
+			party.NoOpinions[userID] = noOpinion
+		} else {
+			delete(party.NoOpinions, userID)
+		}
+
+		// Check if all participants have submitted rankings
+		allRankingsSubmitted := len(party.Submissions) == len(party.Participants)
+
+		if allRankingsSubmitted {
+			if err := finishRanking(ctx, party, ClosedByAllVoted); err != nil {
+				return err
+			}
+		}
+
+		// Save updated party
+		if err := s.redis.SaveParty(ctx, party); err != nil {
+			return fmt.Errorf("failed to save party: %w", err)
+		}
+
+		updatedParty = party
+		return nil
+	})
+
+	return updatedParty, err
+}
+
+// validateBallot checks that a ballot only mentions nominated movies, each
+// at most once, and ranks all of them unless partial ranking is allowed
+func validateBallot(pool []Movie, rankings, noOpinion []string, allowPartial bool) error {
+	nominatedMovieIDs := make(map[string]bool)
+	for _, movie := range pool {
+		nominatedMovieIDs[movie.ID] = true
+	}
+
+	seen := make(map[string]bool)
+	for _, movieID := range append(append([]string{}, rankings...), noOpinion...) {
+		if !nominatedMovieIDs[movieID] {
+			return ErrInvalidRanking.WithMessage("invalid movie ID in ranking: %s", movieID).WithDetail("movie_id", movieID)
+		}
+		if seen[movieID] {
+			return ErrInvalidRanking.WithMessage("movie %s appears more than once", movieID).WithDetail("movie_id", movieID)
+		}
+		seen[movieID] = true
+	}
+
+	if !allowPartial {
+		if len(noOpinion) > 0 {
+			return ErrInvalidRanking.WithMessage("this party requires every nominated movie to be ranked")
+		}
+		if len(rankings) != len(pool) {
+			return ErrInvalidRanking.WithMessage("ranking must include all %d nominated movies", len(pool)).
+				WithDetail("expected", len(pool))
+		}
+	}
+
+	if len(rankings) == 0 {
+		return ErrInvalidRanking.WithMessage("ranking must include at least one movie")
+	}
+
+	return nil
+}
+
+// CloseRanking ends the ranking phase with the ballots received so far
+func (s *Service) CloseRanking(ctx context.Context, partyID, hostID string) (*Party, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.CloseRanking", tracing.KeyPartyID.String(partyID), tracing.KeyUserID.String(hostID))
+	defer span.End()
+
+	var updatedParty *Party
+
+	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
+		// Get current party state
+		party, err := s.redis.GetParty(ctx, partyID)
+		if err != nil {
+			return fmt.Errorf("failed to get party: %w", err)
+		}
+		if party == nil {
+			return ErrPartyNotFound
+		}
+
+		// Validate host permissions
+		if !party.IsHost(hostID) {
+			return ErrNotHost.WithMessage("only the host can close ranking")
+		}
+
+		// Validate party phase
+		if party.Phase != PhaseRanking {
+			return ErrWrongPhase.WithMessage("ranking is not open").WithDetail("phase", party.Phase)
+		}
+
+		if err := finishRanking(ctx, party, ClosedByHost); err != nil {
+			return err
+		}
+
+		// Save updated party
+		if err := s.redis.SaveParty(ctx, party); err != nil {
+			return fmt.Errorf("failed to save party: %w", err)
+		}
+
+		updatedParty = party
+		return nil
+	})
+
+	return updatedParty, err
+}
+
+// finishRanking calculates the winner from the ballots received, records
+// the results and moves the party to the finished phase. If the ballots
+// cannot be counted it returns the error and leaves the party in ranking.
+func finishRanking(ctx context.Context, party *Party, closedBy string) error {
+	ballots := party.Ballots()
+
+	// Calculate final results using the configured voting method
+	method := ""
+	tieBreak := ""
+	var winners []Movie
+	var tie *TieError
+	if len(ballots) > 0 {
+		var err error
+		winners, method, tieBreak, err = Tally(party.Settings.VotingMethod, party.Settings.WinnerCount, party.NominationPool, ballots)
+		if errors.As(err, &tie) {
+			logging.FromContext(ctx).Info("Ranking ended in an exact tie", "movies", len(tie.Movies))
+		} else if err != nil {
+			return fmt.Errorf("failed to calculate winner: %w", err)
+		}
+	} else {
+		logging.FromContext(ctx).Info("Ranking closed without any ballots")
+	}
+	if len(winners) > 0 {
+		party.Winners = winners
+		party.Winner = &party.Winners[0]
+	}
+
+	// Record who did not vote
+	nonVoters := make([]string, 0)
+	for userID := range party.Participants {
+		if _, submitted := party.Submissions[userID]; !submitted {
+			nonVoters = append(nonVoters, userID)
+		}
+	}
+	sort.Strings(nonVoters)
+
+	party.Results = &Results{
+		ClosedBy:    closedBy,
+		Method:      method,
+		BallotCount: len(ballots),
+		NonVoters:   nonVoters,
+		TieBreak:    tieBreak,
+		ClosedAt:    time.Now(),
+	}
+	if party.Weighted() {
+		party.Results.Weights = party.VoterWeights()
+	}
+	party.RankingDeadline = nil
+	party.Phase = PhaseFinished
+
+	if tie == nil {
+		return nil
+	}
+
+	// Settle the tie with a runoff vote if configured, otherwise in favor
+	// of the earliest nomination
+	if party.Settings.RunoffOnTie {
+		deadline := time.Now().Add(time.Duration(party.Settings.RunoffSeconds) * time.Second)
+		party.Runoff = &Runoff{
+			Movies:   tie.Movies,
+			Votes:    make(map[string]string),
+			Deadline: &deadline,
+		}
+		party.Phase = PhaseRunoff
+		return nil
+	}
+	setWinner(party, tie.Movies[0], TieBreakNominationOrder)
+	return nil
+}
+
+// setWinner adds the winner of a tie to the winners already decided
+func setWinner(party *Party, winner Movie, tieBreak string) {
+	party.Winners = append(party.Winners, winner)
+	party.Winner = &party.Winners[0]
+	party.Results.TieBreak = tieBreak
+}
+
+// VoteRunoff records a participant's vote in the runoff between tied movies
+func (s *Service) VoteRunoff(ctx context.Context, partyID, userID, movieID string) (*Party, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.VoteRunoff", tracing.KeyPartyID.String(partyID), tracing.KeyUserID.String(userID))
+	defer span.End()
+
+	var updatedParty *Party
+
+	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
+		// Get current party state
+		party, err := s.redis.GetParty(ctx, partyID)
+		if err != nil {
+			return fmt.Errorf("failed to get party: %w", err)
+		}
+		if party == nil {
+			return ErrPartyNotFound
+		}
+
+		// Validate party phase
+		if party.Phase != PhaseRunoff || party.Runoff == nil {
+			return ErrWrongPhase.WithMessage("there is no runoff vote").WithDetail("phase", party.Phase)
+		}
+
+		// Only participants may vote
+		if party.GetParticipant(userID) == nil {
+			return ErrNotParticipant.WithDetail("user_id", userID)
+		}
+
+		if _, ok := runoffMovie(party.Runoff, movieID); !ok {
+			return ErrInvalidVote.WithMessage("movie %s is not in the runoff", movieID).WithDetail("movie_id", movieID)
+		}
+
+		// Record the vote, replacing any earlier vote
+		party.Runoff.Votes[userID] = movieID
+
+		// Decide the runoff once all participants have voted
+		if len(party.Runoff.Votes) >= len(party.Participants) {
+			resolveRunoff(party)
+		}
+
+		// Save updated party
+		if err := s.redis.SaveParty(ctx, party); err != nil {
+			return fmt.Errorf("failed to save party: %w", err)
+		}
+
+		updatedParty = party
+		return nil
+	})
+
+	return updatedParty, err
+}
+
+// DecideRunoff lets the host pick the winner of a runoff directly
+func (s *Service) DecideRunoff(ctx context.Context, partyID, hostID, movieID string) (*Party, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.DecideRunoff", tracing.KeyPartyID.String(partyID), tracing.KeyUserID.String(hostID))
+	defer span.End()
+
+	var updatedParty *Party
+
+	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
+		// Get current party state
+		party, err := s.redis.GetParty(ctx, partyID)
+		if err != nil {
+			return fmt.Errorf("failed to get party: %w", err)
+		}
+		if party == nil {
+			return ErrPartyNotFound
+		}
+
+		// Validate host permissions
+		if !party.IsHost(hostID) {
+			return ErrNotHost.WithMessage("only the host can decide the runoff")
+		}
+
+		// Validate party phase
+		if party.Phase != PhaseRunoff || party.Runoff == nil {
+			return ErrWrongPhase.WithMessage("there is no runoff vote").WithDetail("phase", party.Phase)
+		}
+
+		movie, ok := runoffMovie(party.Runoff, movieID)
+		if !ok {
+			return ErrInvalidVote.WithMessage("movie %s is not in the runoff", movieID).WithDetail("movie_id", movieID)
+		}
+
+		setWinner(party, movie, TieBreakHost)
+		endRunoff(party)
+
+		// Save updated party
+		if err := s.redis.SaveParty(ctx, party); err != nil {
+			return fmt.Errorf("failed to save party: %w", err)
+		}
+
+		updatedParty = party
+		return nil
+	})
+
+	return updatedParty, err
+}
+
+// resolveRunoff decides the runoff with the votes received. If the runoff
+// is tied as well, the earliest nominated of the leading movies wins.
+func resolveRunoff(party *Party) {
+	counts := make(map[string]float64)
+	for userID, movieID := range party.Runoff.Votes {
+		weight := DefaultVoterWeight
+		if participant := party.GetParticipant(userID); participant != nil {
+			weight = participant.VoteWeight()
+		}
+		counts[movieID] += weight
+	}
+
+	winner := party.Runoff.Movies[0]
+	tieBreak := TieBreakRunoff
+	for _, movie := range party.Runoff.Movies[1:] {
+		if counts[movie.ID] > counts[winner.ID]+thresholdEpsilon {
+			winner = movie
+		}
+	}
+	for _, movie := range party.Runoff.Movies {
+		if movie.ID != winner.ID && sameTally(counts[movie.ID], counts[winner.ID]) {
+			tieBreak = TieBreakNominationOrder
+		}
+	}
+
+	setWinner(party, winner, tieBreak)
+	endRunoff(party)
+}
+
+// endRunoff closes the runoff and finishes the party
+func endRunoff(party *Party) {
+	party.Runoff.Deadline = nil
+	party.Phase = PhaseFinished
+}
+
+// runoffMovie returns the runoff movie with the given ID
+func runoffMovie(runoff *Runoff, movieID string) (Movie, bool) {
+	for _, movie := range runoff.Movies {
+		if movie.ID == movieID {
+			return movie, true
+		}
+	}
+	return Movie{}, false
+}
+
+// Rematch archives the finished round and starts a new one in the lobby or
+// nominating phase. Participants and their tokens are kept.
+func (s *Service) Rematch(ctx context.Context, partyID, hostID, phase string, carryOverPool bool) (*Party, error) {
+	ctx, span := tracing.Start(ctx, "party.Service.Rematch", tracing.KeyPartyID.String(partyID), tracing.KeyUserID.String(hostID))
+	defer span.End()
+
+	if phase != PhaseLobby && phase != PhaseNominating {
+		return nil, ErrInvalidRequest.WithMessage("rematch phase must be %q or %q", PhaseLobby, PhaseNominating)
+	}
+
+	var updatedParty *Party
+
+	err := s.WithLock(ctx, partyID, func(ctx context.Context) error {
+		// Get current party state
+		party, err := s.redis.GetParty(ctx, partyID)
+		if err != nil {
+			return fmt.Errorf("failed to get party: %w", err)
+		}
+		if party == nil {
+			return ErrPartyNotFound
+		}
+
+		// Validate host permissions
+		if !party.IsHost(hostID) {
+			return ErrNotHost.WithMessage("only the host can start a rematch")
+		}
+
+		// Validate party phase
+		if party.Phase != PhaseFinished {
+			return ErrWrongPhase.WithMessage("party must be finished to start a rematch").WithDetail("phase", party.Phase)
+		}
+
+		// Archive the finished round
+		party.Rounds = append(party.Rounds, Round{
+			Number:         len(party.Rounds) + 1,
+			NominationPool: party.NominationPool,
+			Winners:        party.Winners,
+			Results:        party.Results,
+			Settings:       party.Settings,
+		})
+
+		// Optionally keep the movies that did not win
+		pool := make([]Movie, 0)
+		if carryOverPool {
+			won := make(map[string]bool)
+			for _, winner := range party.Winners {
+				won[winner.ID] = true
+			}
+			if party.Winner != nil {
+				won[party.Winner.ID] = true
+			}
+			for _, movie := range party.NominationPool {
+				if !won[movie.ID] {
+					pool = append(pool, movie)
+				}
+			}
+		}
+
+		// Reset the round state
+		party.Phase = phase
+		party.CurrentNomination = nil
+		party.NominationPool = pool
+		party.SuggestionCounts = nil
+		party.Vetoes = nil
+		party.VetoesUsed = nil
+		party.Approvals = nil
+		party.PreEliminated = nil
+		party.Submissions = make(map[string][]string)
+		party.NoOpinions = nil
+		party.RankingDeadline = nil
+		party.Winner = nil
+		party.Winners = nil
+		party.Results = nil
+		party.Runoff = nil
+
+		// Save updated party
+		if err := s.redis.SaveParty(ctx, party); err != nil {
+			return fmt.Errorf("failed to save party: %w", err)
+		}
+
+		updatedParty = party
+		return nil
+	})
+
+	return updatedParty, err
+}
@@ -0,0 +1,409 @@
+package party
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryStore is an in-memory RedisStore. Parties round-trip through JSON
+// so tests see what a real store would hand back.
+type memoryStore struct {
+	mutex   sync.Mutex
+	parties map[string][]byte
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{parties: make(map[string][]byte)}
+}
+
+func (m *memoryStore) GetParty(ctx context.Context, partyID string) (*Party, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	data, ok := m.parties[partyID]
+	if !ok {
+		return nil, nil
+	}
+	var party Party
+	if err := json.Unmarshal(data, &party); err != nil {
+		return nil, err
+	}
+	return &party, nil
+}
+
+func (m *memoryStore) SaveParty(ctx context.Context, party *Party) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	data, err := json.Marshal(party)
+	if err != nil {
+		return err
+	}
+	m.parties[party.ID] = data
+	return nil
+}
+
+func (m *memoryStore) DeleteParty(ctx context.Context, partyID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.parties, partyID)
+	return nil
+}
+
+func (m *memoryStore) AcquireLock(ctx context.Context, partyID string, lockDuration time.Duration) (bool, error) {
+	return true, nil
+}
+
+func (m *memoryStore) ReleaseLock(ctx context.Context, partyID string) error {
+	return nil
+}
+
+func (m *memoryStore) SaveAuthToken(ctx context.Context, token *AuthToken) error {
+	return nil
+}
+
+func (m *memoryStore) GetAuthToken(ctx context.Context, tokenStr string) (*AuthToken, error) {
+	return nil, nil
+}
+
+func (m *memoryStore) RevokeAuthToken(ctx context.Context, tokenStr string) error {
+	return nil
+}
+
+// newTestParty stores a party in the given phase with a host, one guest and
+// two nominated movies
+func newTestParty(t *testing.T, store *memoryStore, phase string, settings PartySettings) *Party {
+	t.Helper()
+
+	party := &Party{
+		ID:    "party-1",
+		Name:  "Movie Night",
+		Phase: phase,
+		Participants: map[string]*Participant{
+			"host":  {ID: "host", Username: "Host", IsHost: true},
+			"guest": {ID: "guest", Username: "Guest"},
+		},
+		Settings:       settings,
+		NominationPool: []Movie{{ID: "603", Title: "The Matrix"}, {ID: "604", Title: "The Matrix Reloaded"}},
+		Submissions:    make(map[string][]string),
+		CreatedAt:      time.Now(),
+	}
+	if err := store.SaveParty(context.Background(), party); err != nil {
+		t.Fatalf("SaveParty: %v", err)
+	}
+	return party
+}
+
+func TestVetoMovieRemovesFromPool(t *testing.T) {
+	store := newMemoryStore()
+	service := NewService(store, nil)
+	newTestParty(t, store, PhaseNominating, PartySettings{VetoesPerUser: 1})
+
+	updated, veto, err := service.VetoMovie(context.Background(), "party-1", "guest", "603")
+	if err != nil {
+		t.Fatalf("VetoMovie: %v", err)
+	}
+
+	if veto.Target != VetoTargetPool || veto.Movie.ID != "603" || veto.VetoedBy != "guest" {
+		t.Errorf("veto = %+v, want guest's pool veto of 603", veto)
+	}
+	if len(updated.NominationPool) != 1 || updated.NominationPool[0].ID != "604" {
+		t.Errorf("nomination pool = %+v, want only 604", updated.NominationPool)
+	}
+	if updated.VetoesUsed["guest"] != 1 {
+		t.Errorf("vetoes used = %d, want 1", updated.VetoesUsed["guest"])
+	}
+}
+
+func TestVetoMovieEnforcesPerUserLimit(t *testing.T) {
+	store := newMemoryStore()
+	service := NewService(store, nil)
+	newTestParty(t, store, PhaseNominating, PartySettings{VetoesPerUser: 1})
+
+	if _, _, err := service.VetoMovie(context.Background(), "party-1", "guest", "603"); err != nil {
+		t.Fatalf("first veto: %v", err)
+	}
+	_, _, err := service.VetoMovie(context.Background(), "party-1", "guest", "604")
+	if !errors.Is(err, ErrNoVetoesLeft) {
+		t.Fatalf("second veto error = %v, want ErrNoVetoesLeft", err)
+	}
+
+	// The limit is per participant
+	if _, _, err := service.VetoMovie(context.Background(), "party-1", "host", "604"); err != nil {
+		t.Errorf("host's veto: %v", err)
+	}
+
+	stored, _ := store.GetParty(context.Background(), "party-1")
+	if len(stored.Vetoes) != 2 {
+		t.Errorf("stored vetoes = %d, want 2", len(stored.Vetoes))
+	}
+}
+
+func TestVetoMovieChecksPhase(t *testing.T) {
+	for _, phase := range []string{PhaseLobby, PhaseFinished} {
+		t.Run(phase, func(t *testing.T) {
+			store := newMemoryStore()
+			service := NewService(store, nil)
+			newTestParty(t, store, phase, PartySettings{VetoesPerUser: 1})
+
+			_, _, err := service.VetoMovie(context.Background(), "party-1", "guest", "603")
+			if !errors.Is(err, ErrWrongPhase) {
+				t.Fatalf("VetoMovie error = %v, want ErrWrongPhase", err)
+			}
+
+			stored, _ := store.GetParty(context.Background(), "party-1")
+			if len(stored.NominationPool) != 2 {
+				t.Errorf("nomination pool = %d movies after a rejected veto, want 2", len(stored.NominationPool))
+			}
+		})
+	}
+}
+
+func TestVetoMovieRejectsNonParticipants(t *testing.T) {
+	store := newMemoryStore()
+	service := NewService(store, nil)
+	newTestParty(t, store, PhaseNominating, PartySettings{VetoesPerUser: 1})
+
+	_, _, err := service.VetoMovie(context.Background(), "party-1", "stranger", "603")
+	if !errors.Is(err, ErrNotParticipant) {
+		t.Fatalf("VetoMovie error = %v, want ErrNotParticipant", err)
+	}
+
+	stored, _ := store.GetParty(context.Background(), "party-1")
+	if len(stored.NominationPool) != 2 || len(stored.Vetoes) != 0 {
+		t.Errorf("party = pool %d, vetoes %d, want nothing vetoed", len(stored.NominationPool), len(stored.Vetoes))
+	}
+}
+
+func TestVetoMovieAnonymousView(t *testing.T) {
+	store := newMemoryStore()
+	service := NewService(store, nil)
+	newTestParty(t, store, PhaseRanking, PartySettings{VetoesPerUser: 1, AnonymousVetoes: true})
+
+	updated, veto, err := service.VetoMovie(context.Background(), "party-1", "guest", "603")
+	if err != nil {
+		t.Fatalf("VetoMovie: %v", err)
+	}
+
+	// The stored party keeps who vetoed so the limit can be enforced
+	if veto.VetoedBy != "guest" || updated.VetoesUsed["guest"] != 1 {
+		t.Errorf("stored veto = %+v, vetoes used = %v, want guest recorded", veto, updated.VetoesUsed)
+	}
+
+	view := updated.View()
+	if len(view.Vetoes) != 1 || view.Vetoes[0].VetoedBy != "" {
+		t.Errorf("view vetoes = %+v, want one veto without vetoed_by", view.Vetoes)
+	}
+	if view.VetoesUsed != nil {
+		t.Errorf("view vetoes used = %v, want nil", view.VetoesUsed)
+	}
+	if view.Vetoes[0].Movie.ID != "603" {
+		t.Errorf("view veto movie = %s, want 603", view.Vetoes[0].Movie.ID)
+	}
+}
+
+func TestViewHidesVetoersWhenAnonymous(t *testing.T) {
+	party := &Party{
+		Vetoes:     []Veto{{Movie: Movie{ID: "603"}, VetoedBy: "guest", Target: VetoTargetPool}},
+		VetoesUsed: map[string]int{"guest": 1},
+	}
+
+	if view := party.View(); view.Vetoes[0].VetoedBy != "guest" || view.VetoesUsed["guest"] != 1 {
+		t.Errorf("view without anonymous vetoes = %+v, want vetoers shown", view.Vetoes)
+	}
+
+	party.Settings.AnonymousVetoes = true
+	view := party.View()
+	if view.Vetoes[0].VetoedBy != "" {
+		t.Errorf("view vetoed_by = %q, want it hidden", view.Vetoes[0].VetoedBy)
+	}
+	if view.VetoesUsed != nil {
+		t.Errorf("view vetoes used = %v, want nil", view.VetoesUsed)
+	}
+
+	// The party itself is left untouched
+	if party.Vetoes[0].VetoedBy != "guest" || party.VetoesUsed["guest"] != 1 {
+		t.Errorf("View modified the party: %+v", party.Vetoes)
+	}
+}
+
+func TestFinishRankingKeepsRankingOnTallyError(t *testing.T) {
+	party := &Party{
+		Phase:        PhaseRanking,
+		Participants: map[string]*Participant{"host": {ID: "host", IsHost: true}},
+		Submissions:  map[string][]string{"host": {"603"}},
+	}
+
+	if err := finishRanking(context.Background(), party, ClosedByHost); err == nil {
+		t.Fatal("finishRanking with an empty pool returned nil, want an error")
+	}
+	if party.Phase != PhaseRanking || party.Results != nil || party.Winner != nil {
+		t.Errorf("party = phase %s, results %+v, winner %+v, want it still ranking", party.Phase, party.Results, party.Winner)
+	}
+}
+
+func TestSetWinnerKeepsDecidedWinners(t *testing.T) {
+	party := &Party{
+		Winners: []Movie{{ID: "603"}},
+		Results: &Results{},
+	}
+	party.Winner = &party.Winners[0]
+
+	setWinner(party, Movie{ID: "604"}, TieBreakRunoff)
+
+	if len(party.Winners) != 2 || party.Winners[0].ID != "603" || party.Winners[1].ID != "604" {
+		t.Errorf("winners = %+v, want 603 then 604", party.Winners)
+	}
+	if party.Winner.ID != "603" {
+		t.Errorf("winner = %s, want 603", party.Winner.ID)
+	}
+	if party.Results.TieBreak != TieBreakRunoff {
+		t.Errorf("tie break = %q, want %q", party.Results.TieBreak, TieBreakRunoff)
+	}
+}
+
+func TestVoteNominationChecksPhaseAndParticipant(t *testing.T) {
+	tests := []struct {
+		name    string
+		phase   string
+		userID  string
+		wantErr error
+	}{
+		{name: "wrong phase", phase: PhaseRanking, userID: "guest", wantErr: ErrWrongPhase},
+		{name: "not a participant", phase: PhaseNominating, userID: "stranger", wantErr: ErrNotParticipant},
+		{name: "participant", phase: PhaseNominating, userID: "guest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemoryStore()
+			service := NewService(store, nil)
+			party := newTestParty(t, store, tt.phase, PartySettings{HostClosesVoting: true})
+			party.CurrentNomination = &NominationVote{
+				Movie:       Movie{ID: "605"},
+				Voters:      map[string]string{"host": VoteYay},
+				SuggestedBy: "host",
+			}
+			if err := store.SaveParty(context.Background(), party); err != nil {
+				t.Fatalf("SaveParty: %v", err)
+			}
+
+			_, err := service.VoteNomination(context.Background(), "party-1", tt.userID, VoteYay)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("VoteNomination: %v", err)
+				}
+			} else if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VoteNomination error = %v, want %v", err, tt.wantErr)
+			}
+
+			_, err = service.RetractVote(context.Background(), "party-1", tt.userID)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("RetractVote: %v", err)
+				}
+			} else if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RetractVote error = %v, want %v", err, tt.wantErr)
+			}
+
+			stored, _ := store.GetParty(context.Background(), "party-1")
+			if _, voted := stored.CurrentNomination.Voters[tt.userID]; voted {
+				t.Errorf("%s still has a vote recorded", tt.userID)
+			}
+		})
+	}
+}
+
+func TestVoteRunoffRejectsNonParticipants(t *testing.T) {
+	store := newMemoryStore()
+	service := NewService(store, nil)
+	party := newTestParty(t, store, PhaseRunoff, PartySettings{})
+	party.Runoff = &Runoff{
+		Movies: []Movie{{ID: "603"}, {ID: "604"}},
+		Votes:  map[string]string{"host": "603"},
+	}
+	if err := store.SaveParty(context.Background(), party); err != nil {
+		t.Fatalf("SaveParty: %v", err)
+	}
+
+	// A second vote from outside the party must not complete the runoff
+	_, err := service.VoteRunoff(context.Background(), "party-1", "stranger", "604")
+	if !errors.Is(err, ErrNotParticipant) {
+		t.Fatalf("VoteRunoff error = %v, want ErrNotParticipant", err)
+	}
+
+	stored, _ := store.GetParty(context.Background(), "party-1")
+	if stored.Phase != PhaseRunoff || len(stored.Runoff.Votes) != 1 {
+		t.Errorf("party = phase %s, votes %v, want the runoff still open with one vote", stored.Phase, stored.Runoff.Votes)
+	}
+}
+
+func TestSubmitApprovalsRejectsNonParticipants(t *testing.T) {
+	store := newMemoryStore()
+	service := NewService(store, nil)
+	party := newTestParty(t, store, PhaseApproval, PartySettings{MaxPoolSize: 1})
+	party.Approvals = map[string][]string{"host": {"603"}}
+	if err := store.SaveParty(context.Background(), party); err != nil {
+		t.Fatalf("SaveParty: %v", err)
+	}
+
+	// An outsider's approvals must not end the round
+	_, err := service.SubmitApprovals(context.Background(), "party-1", "stranger", []string{"604"})
+	if !errors.Is(err, ErrNotParticipant) {
+		t.Fatalf("SubmitApprovals error = %v, want ErrNotParticipant", err)
+	}
+
+	stored, _ := store.GetParty(context.Background(), "party-1")
+	if stored.Phase != PhaseApproval || len(stored.Approvals) != 1 || len(stored.NominationPool) != 2 {
+		t.Errorf("party = phase %s, approvals %v, pool %d, want the round still open", stored.Phase, stored.Approvals, len(stored.NominationPool))
+	}
+}
+
+func TestSubmitRankingRejectsNonParticipants(t *testing.T) {
+	store := newMemoryStore()
+	service := NewService(store, nil)
+	party := newTestParty(t, store, PhaseRanking, PartySettings{})
+	party.Submissions = map[string][]string{"host": {"603", "604"}}
+	if err := store.SaveParty(context.Background(), party); err != nil {
+		t.Fatalf("SaveParty: %v", err)
+	}
+
+	// An outsider's ballot must not be counted or close ranking
+	_, err := service.SubmitRanking(context.Background(), "party-1", "stranger", []string{"604", "603"}, nil)
+	if !errors.Is(err, ErrNotParticipant) {
+		t.Fatalf("SubmitRanking error = %v, want ErrNotParticipant", err)
+	}
+
+	stored, _ := store.GetParty(context.Background(), "party-1")
+	if stored.Phase != PhaseRanking || len(stored.Submissions) != 1 {
+		t.Errorf("party = phase %s, submissions %v, want ranking still open with one ballot", stored.Phase, stored.Submissions)
+	}
+}
+
+func TestCloseVotingChecksPhase(t *testing.T) {
+	store := newMemoryStore()
+	service := NewService(store, nil)
+	party := newTestParty(t, store, PhaseRanking, PartySettings{})
+	party.CurrentNomination = &NominationVote{
+		Movie:       Movie{ID: "605"},
+		Voters:      map[string]string{"host": VoteYay},
+		SuggestedBy: "host",
+	}
+	if err := store.SaveParty(context.Background(), party); err != nil {
+		t.Fatalf("SaveParty: %v", err)
+	}
+
+	_, err := service.CloseVoting(context.Background(), "party-1", "host")
+	if !errors.Is(err, ErrWrongPhase) {
+		t.Fatalf("CloseVoting error = %v, want ErrWrongPhase", err)
+	}
+
+	stored, _ := store.GetParty(context.Background(), "party-1")
+	if stored.CurrentNomination == nil || len(stored.NominationPool) != 2 {
+		t.Errorf("party = nomination %+v, pool %d, want the vote left alone", stored.CurrentNomination, len(stored.NominationPool))
+	}
+}
@@ -0,0 +1,164 @@
+package party
+
+// PartySettings configures how a party runs. The host can change settings
+// while the party is in the lobby.
+type PartySettings struct {
+	VotingMethod          string  `json:"voting_method"`            // "rcv", "stv" or "borda"
+	WinnerCount           int     `json:"winner_count"`             // Number of movies to pick, in watch order
+	NominationRule        string  `json:"nomination_rule"`          // One of the NominationRule* constants
+	NominationThreshold   float64 `json:"nomination_threshold"`     // Share of votes cast that must be yays under the supermajority rule
+	NominationYayCount    int     `json:"nomination_yay_count"`     // Yays needed under the fixed rule
+	NominationQuorum      float64 `json:"nomination_quorum"`        // Share of participants that must vote for a nomination to pass
+	HostVeto              bool    `json:"host_veto"`                // A nay from the host rejects a nomination
+	HostClosesVoting      bool    `json:"host_closes_voting"`       // Nominations stay open until the host closes voting
+	MaxSuggestionsPerUser int     `json:"max_suggestions_per_user"` // 0 means unlimited
+	NominationVoteSeconds int     `json:"nomination_vote_seconds"`  // Time limit for voting on a nomination, 0 means no limit
+	MaxPoolSize           int     `json:"max_pool_size"`            // Movies allowed into ranking, trimmed by an approval round, 0 means unlimited
+	RankingSeconds        int     `json:"ranking_seconds"`          // Time limit for submitting rankings, 0 means no limit
+	RunoffOnTie           bool    `json:"runoff_on_tie"`            // Hold a runoff vote when RCV ends in an exact tie
+	RunoffSeconds         int     `json:"runoff_seconds"`           // Time limit for the runoff vote
+	VetoesPerUser         int     `json:"vetoes_per_user"`          // Vetoes each participant may use per round, 0 means none
+	AnonymousVetoes       bool    `json:"anonymous_vetoes"`         // Hide who used a veto
+	MaxParticipants       int     `json:"max_participants"`         // Including the host, 0 means unlimited
+	SecretBallots         bool    `json:"secret_ballots"`           // Hide who voted for what from other participants
+	AllowPartialRanking   bool    `json:"allow_partial_ranking"`    // Allow ballots that rank only some of the nominated movies
+	HostDisplayName       string  `json:"host_display_name"`
+}
+
+// Voting methods
+const (
+	VotingMethodRCV   = "rcv"   // Ranked-choice voting (instant runoff, repeated for several winners)
+	VotingMethodSTV   = "stv"   // Single transferable vote
+	VotingMethodBorda = "borda" // Borda count
+)
+
+// Defaults and limits for party settings
+const (
+	DefaultNominationThreshold = 2.0 / 3.0
+	DefaultHostDisplayName     = "Host"
+	MaxUsernameLength          = 32
+	MaxNominationVoteSeconds   = 3600
+	MaxRankingSeconds          = 7200
+	MaxWinnerCount             = 10
+	DefaultRunoffSeconds       = 60
+	MinRunoffSeconds           = 10
+	MaxRunoffSeconds           = 600
+	MaxVetoesPerUser           = 5
+	DefaultVoterWeight         = 1.0
+	MaxVoterWeight             = 10.0
+)
+
+// DefaultSettings returns the settings of a newly created party
+func DefaultSettings() PartySettings {
+	return PartySettings{
+		VotingMethod:        VotingMethodRCV,
+		WinnerCount:         1,
+		NominationRule:      NominationRuleMajority,
+		NominationThreshold: DefaultNominationThreshold,
+		RunoffSeconds:       DefaultRunoffSeconds,
+		HostDisplayName:     DefaultHostDisplayName,
+	}
+}
+
+// Normalize fills unset fields with their defaults
+func (s *PartySettings) Normalize() {
+	if s.VotingMethod == "" {
+		s.VotingMethod = VotingMethodRCV
+	}
+	if s.WinnerCount == 0 {
+		s.WinnerCount = 1
+	}
+	if s.NominationRule == "" {
+		s.NominationRule = NominationRuleMajority
+	}
+	if s.NominationThreshold == 0 {
+		s.NominationThreshold = DefaultNominationThreshold
+	}
+	if s.RunoffSeconds == 0 {
+		s.RunoffSeconds = DefaultRunoffSeconds
+	}
+	if s.HostDisplayName == "" {
+		s.HostDisplayName = DefaultHostDisplayName
+	}
+}
+
+// Validate checks that the settings are within the supported ranges
+func (s PartySettings) Validate() error {
+	switch s.VotingMethod {
+	case VotingMethodRCV, VotingMethodSTV, VotingMethodBorda:
+	default:
+		return ErrInvalidSettings.WithMessage("voting_method must be %q, %q or %q", VotingMethodRCV, VotingMethodSTV, VotingMethodBorda).
+			WithDetail("field", "voting_method")
+	}
+
+	if s.WinnerCount < 1 || s.WinnerCount > MaxWinnerCount {
+		return ErrInvalidSettings.WithMessage("winner_count must be between 1 and %d", MaxWinnerCount).
+			WithDetail("field", "winner_count")
+	}
+
+	switch s.NominationRule {
+	case NominationRuleMajority, NominationRuleSupermajority, NominationRuleUnanimous, NominationRuleFixed, NominationRuleAutoAccept:
+	default:
+		return ErrInvalidSettings.WithMessage("unknown nomination_rule %q", s.NominationRule).
+			WithDetail("field", "nomination_rule")
+	}
+
+	if s.NominationThreshold <= 0.5 || s.NominationThreshold > 1 {
+		return ErrInvalidSettings.WithMessage("nomination_threshold must be greater than 0.5 and at most 1").
+			WithDetail("field", "nomination_threshold")
+	}
+	if s.NominationRule == NominationRuleFixed && s.NominationYayCount < 1 {
+		return ErrInvalidSettings.WithMessage("nomination_yay_count must be at least 1 with the fixed rule").
+			WithDetail("field", "nomination_yay_count")
+	}
+	if s.NominationRule == NominationRuleFixed && s.MaxParticipants > 0 && s.NominationYayCount > s.MaxParticipants {
+		return ErrInvalidSettings.WithMessage("nomination_yay_count cannot exceed max_participants").
+			WithDetail("field", "nomination_yay_count")
+	}
+	if s.NominationYayCount < 0 {
+		return ErrInvalidSettings.WithMessage("nomination_yay_count cannot be negative").
+			WithDetail("field", "nomination_yay_count")
+	}
+	if s.NominationQuorum < 0 || s.NominationQuorum > 1 {
+		return ErrInvalidSettings.WithMessage("nomination_quorum must be between 0 and 1").
+			WithDetail("field", "nomination_quorum")
+	}
+	if s.MaxSuggestionsPerUser < 0 {
+		return ErrInvalidSettings.WithMessage("max_suggestions_per_user cannot be negative").
+			WithDetail("field", "max_suggestions_per_user")
+	}
+	if s.NominationVoteSeconds < 0 || s.NominationVoteSeconds > MaxNominationVoteSeconds {
+		return ErrInvalidSettings.WithMessage("nomination_vote_seconds must be between 0 and %d", MaxNominationVoteSeconds).
+			WithDetail("field", "nomination_vote_seconds")
+	}
+	if s.MaxPoolSize < 0 {
+		return ErrInvalidSettings.WithMessage("max_pool_size cannot be negative").
+			WithDetail("field", "max_pool_size")
+	}
+	if s.MaxPoolSize > 0 && s.MaxPoolSize < s.WinnerCount {
+		return ErrInvalidSettings.WithMessage("max_pool_size cannot be lower than winner_count").
+			WithDetail("field", "max_pool_size")
+	}
+	if s.RankingSeconds < 0 || s.RankingSeconds > MaxRankingSeconds {
+		return ErrInvalidSettings.WithMessage("ranking_seconds must be between 0 and %d", MaxRankingSeconds).
+			WithDetail("field", "ranking_seconds")
+	}
+	if s.RunoffSeconds < MinRunoffSeconds || s.RunoffSeconds > MaxRunoffSeconds {
+		return ErrInvalidSettings.WithMessage("runoff_seconds must be between %d and %d", MinRunoffSeconds, MaxRunoffSeconds).
+			WithDetail("field", "runoff_seconds")
+	}
+	if s.VetoesPerUser < 0 || s.VetoesPerUser > MaxVetoesPerUser {
+		return ErrInvalidSettings.WithMessage("vetoes_per_user must be between 0 and %d", MaxVetoesPerUser).
+			WithDetail("field", "vetoes_per_user")
+	}
+	if s.MaxParticipants < 0 {
+		return ErrInvalidSettings.WithMessage("max_participants cannot be negative").
+			WithDetail("field", "max_participants")
+	}
+	if len(s.HostDisplayName) > MaxUsernameLength {
+		return ErrInvalidSettings.WithMessage("host_display_name cannot be longer than %d characters", MaxUsernameLength).
+			WithDetail("field", "host_display_name")
+	}
+
+	return nil
+}
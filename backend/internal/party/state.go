@@ -1,26 +1,132 @@
 package party
 
-import "time"
+import (
+	"sort"
+	"time"
+)
 
-// Movie represents a movie with TMDB data
+// Movie represents a nominatable piece of content with TMDB data: a movie,
+// a TV series, a season or a single episode
 type Movie struct {
-	ID         string `json:"id"`
-	Title      string `json:"title"`
-	Year       string `json:"year"`
-	PosterPath string `json:"poster_path"`
+	ID          string `json:"id"`
+	ContentType string `json:"content_type"` // "movie", "tv", "season" or "episode"
+	Title       string `json:"title"`
+	Year        string `json:"year"`
+	PosterPath  string `json:"poster_path"`
+
+	// Details shown to voters; search results only carry overview and rating
+	Overview      string       `json:"overview,omitempty"`
+	Rating        float64      `json:"rating,omitempty"`  // TMDB vote average out of 10
+	Runtime       int          `json:"runtime,omitempty"` // Minutes
+	Genres        []string     `json:"genres,omitempty"`
+	Certification string       `json:"certification,omitempty"` // Age rating, e.g. "PG-13"
+	Director      string       `json:"director,omitempty"`
+	Cast          []CastMember `json:"cast,omitempty"`        // Top-billed cast in billing order
+	TrailerKey    string       `json:"trailer_key,omitempty"` // YouTube video key
+
+	// TV fields; counts describe the series or season being nominated
+	ShowTitle     string `json:"show_title,omitempty"`
+	SeasonCount   int    `json:"season_count,omitempty"`
+	EpisodeCount  int    `json:"episode_count,omitempty"`
+	SeasonNumber  int    `json:"season_number,omitempty"`
+	EpisodeNumber int    `json:"episode_number,omitempty"`
+}
+
+// Content type constants
+const (
+	ContentTypeMovie   = "movie"
+	ContentTypeTV      = "tv"
+	ContentTypeSeason  = "season"
+	ContentTypeEpisode = "episode"
+)
+
+// SearchMediaTypeMulti searches movies and TV series together
+const SearchMediaTypeMulti = "multi"
+
+// CastMember represents an actor and the role they play
+type CastMember struct {
+	Name      string `json:"name"`
+	Character string `json:"character"`
+}
+
+// SearchOptions configures a movie search beyond the query string
+type SearchOptions struct {
+	MediaType          string `json:"media_type,omitempty"`           // "movie" (default), "tv" or "multi"
+	Page               int    `json:"page,omitempty"`                 // 1-based result page, defaults to 1
+	Year               int    `json:"year,omitempty"`                 // Matches any release year of the movie
+	PrimaryReleaseYear int    `json:"primary_release_year,omitempty"` // Matches only the original release year
+	Language           string `json:"language,omitempty"`             // ISO 639-1 code, optionally with region (e.g. "de" or "pt-BR")
+	Region             string `json:"region,omitempty"`               // ISO 3166-1 code used to filter release dates
+	IncludeAdult       bool   `json:"include_adult,omitempty"`
+}
+
+// Search limits enforced by TMDB
+const (
+	MaxSearchPage = 500
+	MinSearchYear = 1874
+)
+
+// Validate checks that the search options are within the ranges TMDB accepts
+func (o SearchOptions) Validate() error {
+	switch o.MediaType {
+	case "", ContentTypeMovie, ContentTypeTV, SearchMediaTypeMulti:
+	default:
+		return ErrInvalidRequest.WithMessage("media_type must be %q, %q or %q", ContentTypeMovie, ContentTypeTV, SearchMediaTypeMulti)
+	}
+
+	if o.Page < 0 || o.Page > MaxSearchPage {
+		return ErrInvalidRequest.WithMessage("page must be between 1 and %d", MaxSearchPage)
+	}
+
+	maxYear := time.Now().Year() + 10
+	if o.Year != 0 && (o.Year < MinSearchYear || o.Year > maxYear) {
+		return ErrInvalidRequest.WithMessage("year must be between %d and %d", MinSearchYear, maxYear)
+	}
+	if o.PrimaryReleaseYear != 0 && (o.PrimaryReleaseYear < MinSearchYear || o.PrimaryReleaseYear > maxYear) {
+		return ErrInvalidRequest.WithMessage("primary_release_year must be between %d and %d", MinSearchYear, maxYear)
+	}
+
+	if o.Language != "" && len(o.Language) != 2 && len(o.Language) != 5 {
+		return ErrInvalidRequest.WithMessage("language must be an ISO 639-1 code such as \"en\" or \"en-US\"")
+	}
+	if o.Region != "" && len(o.Region) != 2 {
+		return ErrInvalidRequest.WithMessage("region must be an ISO 3166-1 code such as \"US\"")
+	}
+
+	return nil
+}
+
+// SearchResult represents one page of movie search results
+type SearchResult struct {
+	Movies       []Movie `json:"movies"`
+	Page         int     `json:"page"`
+	TotalResults int     `json:"total_results"`
+	TotalPages   int     `json:"total_pages"`
 }
 
 // Participant represents a user in a party
 type Participant struct {
-	ID       string `json:"id"` // Unique ID for this participant
-	Username string `json:"username"`
-	IsHost   bool   `json:"is_host"`
+	ID       string  `json:"id"` // Unique ID for this participant
+	Username string  `json:"username"`
+	IsHost   bool    `json:"is_host"`
+	Weight   float64 `json:"weight"` // How much the participant's votes count, set by the host
+}
+
+// VoteWeight returns the participant's voter weight. Participants who
+// joined before weights existed count as one.
+func (p *Participant) VoteWeight() float64 {
+	if p.Weight == 0 {
+		return DefaultVoterWeight
+	}
+	return p.Weight
 }
 
 // NominationVote represents a movie being voted on for nomination
 type NominationVote struct {
-	Movie  Movie             `json:"movie"`
-	Voters map[string]string `json:"voters"` // Map participant ID to their vote ("yay" or "nay")
+	Movie       Movie             `json:"movie"`
+	Voters      map[string]string `json:"voters"`             // Map participant ID to their vote ("yay", "nay" or "abstain")
+	SuggestedBy string            `json:"suggested_by"`       // Participant ID of the suggester
+	Deadline    *time.Time        `json:"deadline,omitempty"` // Voting closes with the votes received at this time
 }
 
 // Party represents the complete state of a party session
@@ -28,23 +134,109 @@ type Party struct {
 	ID           string                  `json:"id"`
 	Name         string                  `json:"name"`
 	Participants map[string]*Participant `json:"participants"` // Map of participant ID to participant
-	Phase        string                  `json:"phase"`        // "lobby", "nominating", "ranking", "finished"
+	Phase        string                  `json:"phase"`        // "lobby", "nominating", "approval", "ranking", "runoff", "finished"
 	CreatedAt    time.Time               `json:"created_at"`
+	Settings     PartySettings           `json:"settings"`
 
 	// Nomination phase fields
 	CurrentNomination *NominationVote `json:"current_nomination"`
 	NominationPool    []Movie         `json:"nomination_pool"`
+	SuggestionCounts  map[string]int  `json:"suggestion_counts,omitempty"` // Map participant ID to number of movies suggested
+
+	// Vetoes used this round, in order
+	Vetoes     []Veto         `json:"vetoes,omitempty"`
+	VetoesUsed map[string]int `json:"vetoes_used,omitempty"` // Map participant ID to number of vetoes used
+
+	// Approval phase fields, used when the nomination pool is larger than
+	// max_pool_size
+	Approvals     map[string][]string `json:"approvals,omitempty"`      // Map participant ID to the movie IDs they approve of
+	PreEliminated []Movie             `json:"pre_eliminated,omitempty"` // Movies removed from the pool by the approval round
 
 	// Ranking phase fields
-	Submissions map[string][]string `json:"submissions"` // Map participant ID to their ranked list of Movie IDs
-	Winner      *Movie              `json:"winner"`
+	Submissions     map[string][]string `json:"submissions"`                // Map participant ID to their ranked list of Movie IDs
+	NoOpinions      map[string][]string `json:"no_opinions,omitempty"`      // Map participant ID to movies they declined to rank
+	RankingDeadline *time.Time          `json:"ranking_deadline,omitempty"` // Ranking closes with the ballots received at this time
+	Winner          *Movie              `json:"winner"`                     // First of Winners
+	Winners         []Movie             `json:"winners,omitempty"`          // Winning movies in watch order
+	Results         *Results            `json:"results,omitempty"`
+
+	// Runoff phase fields
+	Runoff *Runoff `json:"runoff,omitempty"`
+
+	// Previous rounds of the party, oldest first
+	Rounds []Round `json:"rounds,omitempty"`
+}
+
+// Veto records a participant removing a movie from consideration
+type Veto struct {
+	Movie    Movie     `json:"movie"`
+	VetoedBy string    `json:"vetoed_by,omitempty"` // Participant ID, omitted with anonymous vetoes
+	Target   string    `json:"target"`              // One of the VetoTarget* constants
+	Phase    string    `json:"phase"`               // Phase the veto was used in
+	At       time.Time `json:"at"`
+}
+
+// What a veto removed the movie from
+const (
+	VetoTargetNomination = "nomination" // The nomination being voted on was blocked
+	VetoTargetPool       = "pool"       // The movie was removed from the nomination pool
+)
+
+// Round archives a finished round when the host starts a rematch
+type Round struct {
+	Number         int           `json:"number"`
+	NominationPool []Movie       `json:"nomination_pool"`
+	Winners        []Movie       `json:"winners"`
+	Results        *Results      `json:"results"`
+	Settings       PartySettings `json:"settings"`
 }
 
+// Runoff is a head-to-head vote between movies tied at the end of RCV
+type Runoff struct {
+	Movies   []Movie           `json:"movies"`
+	Votes    map[string]string `json:"votes"` // Map participant ID to the ID of the movie they voted for
+	Deadline *time.Time        `json:"deadline,omitempty"`
+}
+
+// Results records how the ranking phase ended
+type Results struct {
+	ClosedBy    string    `json:"closed_by"` // One of the ClosedBy* constants
+	Method      string    `json:"method"`    // How the winners were ordered, one of the Ordering* constants
+	BallotCount int       `json:"ballot_count"`
+	NonVoters   []string  `json:"non_voters"`          // IDs of participants who did not submit a ranking
+	TieBreak    string    `json:"tie_break,omitempty"` // How an exact tie was broken, one of the TieBreak* constants
+	ClosedAt    time.Time `json:"closed_at"`
+
+	// Voter weights the ballots were counted with, omitted when every
+	// participant counted as one
+	Weights map[string]float64 `json:"weights,omitempty"`
+}
+
+// How the ranking phase was closed
+const (
+	ClosedByAllVoted = "all_voted"
+	ClosedByHost     = "host"
+	ClosedByDeadline = "deadline"
+)
+
+// How an exact tie was broken
+const (
+	TieBreakRunoff          = "runoff"           // Runoff vote
+	TieBreakHost            = "host"             // Host override
+	TieBreakNominationOrder = "nomination_order" // Earliest nominated movie
+)
+
+// VoteHidden replaces votes in the party state sent to clients when the
+// party uses secret ballots
+const VoteHidden = "hidden"
+
 // Phase constants
 const (
 	PhaseLobby      = "lobby"
 	PhaseNominating = "nominating"
+	PhaseApproval   = "approval"
 	PhaseRanking    = "ranking"
+	PhaseRunoff     = "runoff"
 	PhaseFinished   = "finished"
 )
 
@@ -58,6 +250,7 @@ func (p *Party) AddParticipant(userID, username string, isHost bool) {
 		ID:       userID,
 		Username: username,
 		IsHost:   isHost,
+		Weight:   DefaultVoterWeight,
 	}
 }
 
@@ -91,3 +284,131 @@ func (p *Party) GetHost() *Participant {
 func (p *Party) ParticipantCount() int {
 	return len(p.Participants)
 }
+
+// VoterWeights maps every participant ID to their voter weight
+func (p *Party) VoterWeights() map[string]float64 {
+	weights := make(map[string]float64, len(p.Participants))
+	for userID, participant := range p.Participants {
+		weights[userID] = participant.VoteWeight()
+	}
+	return weights
+}
+
+// Weighted reports whether any participant's votes count other than one
+func (p *Party) Weighted() bool {
+	for _, participant := range p.Participants {
+		if participant.VoteWeight() != DefaultVoterWeight {
+			return true
+		}
+	}
+	return false
+}
+
+// UsernameTaken checks if another participant already uses the username
+func (p *Party) UsernameTaken(username, exceptUserID string) bool {
+	for _, participant := range p.Participants {
+		if participant.ID != exceptUserID && participant.Username == username {
+			return true
+		}
+	}
+	return false
+}
+
+// NextDeadline returns the earliest pending deadline of the party
+func (p *Party) NextDeadline() (time.Time, bool) {
+	if p.CurrentNomination != nil && p.CurrentNomination.Deadline != nil {
+		return *p.CurrentNomination.Deadline, true
+	}
+	if p.Phase == PhaseRanking && p.RankingDeadline != nil {
+		return *p.RankingDeadline, true
+	}
+	if p.Phase == PhaseRunoff && p.Runoff != nil && p.Runoff.Deadline != nil {
+		return *p.Runoff.Deadline, true
+	}
+	return time.Time{}, false
+}
+
+// View returns the party state as it may be shown to participants. With
+// secret ballots, who voted is kept but how they voted is hidden. With
+// anonymous vetoes, who used them is hidden.
+func (p *Party) View() *Party {
+	if !p.Settings.SecretBallots && !p.Settings.AnonymousVetoes {
+		return p
+	}
+
+	view := *p
+	if p.Settings.AnonymousVetoes {
+		view.Vetoes = make([]Veto, len(p.Vetoes))
+		for i, veto := range p.Vetoes {
+			view.Vetoes[i] = veto.Anonymous()
+		}
+		view.VetoesUsed = nil
+	}
+	if !p.Settings.SecretBallots {
+		return &view
+	}
+
+	if p.CurrentNomination != nil {
+		nomination := *p.CurrentNomination
+		nomination.Voters = make(map[string]string, len(p.CurrentNomination.Voters))
+		for userID := range p.CurrentNomination.Voters {
+			nomination.Voters[userID] = VoteHidden
+		}
+		view.CurrentNomination = &nomination
+	}
+	if p.Runoff != nil {
+		runoff := *p.Runoff
+		runoff.Votes = make(map[string]string, len(p.Runoff.Votes))
+		for userID := range p.Runoff.Votes {
+			runoff.Votes[userID] = VoteHidden
+		}
+		view.Runoff = &runoff
+	}
+	view.Approvals = hideBallots(p.Approvals)
+	view.Submissions = hideBallots(p.Submissions)
+	view.NoOpinions = hideBallots(p.NoOpinions)
+	return &view
+}
+
+// Anonymous returns the veto without who used it
+func (v Veto) Anonymous() Veto {
+	v.VetoedBy = ""
+	return v
+}
+
+// hideBallots keeps who submitted a ballot but not its contents
+func hideBallots(ballots map[string][]string) map[string][]string {
+	if ballots == nil {
+		return nil
+	}
+
+	hidden := make(map[string][]string, len(ballots))
+	for userID := range ballots {
+		hidden[userID] = []string{}
+	}
+	return hidden
+}
+
+// Ballots returns the submitted ballots ordered by participant ID
+func (p *Party) Ballots() []Ballot {
+	userIDs := make([]string, 0, len(p.Submissions))
+	for userID := range p.Submissions {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Strings(userIDs)
+
+	ballots := make([]Ballot, 0, len(userIDs))
+	for _, userID := range userIDs {
+		weight := DefaultVoterWeight
+		if participant := p.GetParticipant(userID); participant != nil {
+			weight = participant.VoteWeight()
+		}
+		ballots = append(ballots, Ballot{
+			UserID:    userID,
+			Ranks:     p.Submissions[userID],
+			NoOpinion: p.NoOpinions[userID],
+			Weight:    weight,
+		})
+	}
+	return ballots
+}
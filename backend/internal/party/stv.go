@@ -0,0 +1,149 @@
+package party
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+)
+
+// CalculateSequentialIRVWinners elects seats movies by running instant-runoff
+// voting repeatedly, excluding the movies already elected each time. Winners
+// are returned in the order they were elected. An exact tie for the last
+// seat returns a *TieError along with the winners already elected, so it can
+// be settled like a single-winner tie. An exact tie for an earlier seat goes
+// to the movie nominated first so the later seats can still be filled, and
+// is reported as TieBreakNominationOrder.
+func CalculateSequentialIRVWinners(pool []Movie, ballots []Ballot, seats int) ([]Movie, string, error) {
+	remaining := append([]Movie(nil), pool...)
+	winners := make([]Movie, 0, seats)
+	tieBreak := ""
+
+	for len(winners) < seats && len(remaining) > 0 {
+		winner, err := CalculateWinner(remaining, ballots)
+		var tie *TieError
+		if errors.As(err, &tie) {
+			if len(winners) == seats-1 {
+				return winners, tieBreak, tie
+			}
+			winner = &tie.Movies[0]
+			tieBreak = TieBreakNominationOrder
+		} else if err != nil {
+			return nil, "", err
+		}
+		winners = append(winners, *winner)
+
+		// Exclude the winner from the next round
+		for i, movie := range remaining {
+			if movie.ID == winner.ID {
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				break
+			}
+		}
+	}
+
+	return winners, tieBreak, nil
+}
+
+// CalculateSTVWinners elects seats movies using the single transferable vote.
+// A movie is elected once its votes exceed the Droop quota, total/(seats+1).
+// Its surplus votes then move on to their next choices at a reduced value
+// (Gregory method), so a popular pick does not waste the votes of its
+// supporters. Winners are returned in the order they were elected.
+func CalculateSTVWinners(pool []Movie, ballots []Ballot, seats int) ([]Movie, error) {
+	if len(pool) == 0 {
+		return nil, fmt.Errorf("no movies in nomination pool")
+	}
+
+	if len(ballots) == 0 {
+		return nil, fmt.Errorf("no voting submissions received")
+	}
+
+	if seats > len(pool) {
+		seats = len(pool)
+	}
+
+	movieMap := make(map[string]Movie)
+	activeMovies := make(map[string]bool)
+	for _, movie := range pool {
+		movieMap[movie.ID] = movie
+		activeMovies[movie.ID] = true
+	}
+
+	// Every ballot starts at its full weight
+	values := make([]float64, len(ballots))
+	totalValue := 0.0
+	for i, ballot := range ballots {
+		values[i] = ballot.weight()
+		totalValue += values[i]
+	}
+	quota := totalValue / float64(seats+1)
+
+	slog.Debug("Starting STV calculation", "movies", len(pool), "ballots", len(ballots), "seats", seats)
+
+	winners := make([]Movie, 0, seats)
+	round := 1
+	for len(winners) < seats {
+		// Count the current value of each ballot toward its top active choice
+		tallies := make(map[string]float64)
+		for movieID := range activeMovies {
+			tallies[movieID] = 0
+		}
+		for i, ballot := range ballots {
+			if choice := getFirstActiveChoice(ballot.Ranks, activeMovies); choice != "" {
+				tallies[choice] += values[i]
+			}
+		}
+		ordered := orderByTally(pool, activeMovies, tallies)
+
+		slog.Debug("STV round", "round", round, "movies_remaining", len(activeMovies), "seats_left", seats-len(winners))
+
+		// Fill the remaining seats if every active movie is needed
+		if len(ordered) <= seats-len(winners) {
+			for _, movieID := range ordered {
+				winners = append(winners, movieMap[movieID])
+			}
+			break
+		}
+
+		// Elect the leading movie if it exceeds the quota and pass on its surplus
+		if leader := ordered[0]; tallies[leader] > quota {
+			winners = append(winners, movieMap[leader])
+			slog.Debug("STV elected movie", "movie_id", leader, "round", round)
+
+			transferRatio := (tallies[leader] - quota) / tallies[leader]
+			for i, ballot := range ballots {
+				if getFirstActiveChoice(ballot.Ranks, activeMovies) == leader {
+					values[i] *= transferRatio
+				}
+			}
+			delete(activeMovies, leader)
+			round++
+			continue
+		}
+
+		// Otherwise eliminate the movie with the fewest votes
+		loser := ordered[len(ordered)-1]
+		delete(activeMovies, loser)
+		slog.Debug("STV eliminated movie", "movie_id", loser, "round", round)
+		round++
+	}
+
+	return winners, nil
+}
+
+// orderByTally returns the active movies from most to fewest votes. Ties
+// keep nomination order.
+func orderByTally(pool []Movie, activeMovies map[string]bool, tallies map[string]float64) []string {
+	ordered := make([]string, 0, len(activeMovies))
+	for _, movie := range pool {
+		if activeMovies[movie.ID] {
+			ordered = append(ordered, movie.ID)
+		}
+	}
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return tallies[ordered[i]] > tallies[ordered[j]]
+	})
+	return ordered
+}
@@ -0,0 +1,267 @@
+package party
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// movies returns a nomination pool with the given IDs, in nomination order
+func movies(ids ...string) []Movie {
+	pool := make([]Movie, len(ids))
+	for i, id := range ids {
+		pool[i] = Movie{ID: id, Title: "Movie " + id}
+	}
+	return pool
+}
+
+// movieIDs returns the IDs of movies, in order
+func movieIDs(movies []Movie) []string {
+	ids := make([]string, len(movies))
+	for i, movie := range movies {
+		ids[i] = movie.ID
+	}
+	return ids
+}
+
+func TestSequentialIRVTieForEarlierSeat(t *testing.T) {
+	// All three movies tie for the first seat; C then beats B for the second
+	ballots := []Ballot{
+		{UserID: "a", Ranks: []string{"A", "C"}},
+		{UserID: "b", Ranks: []string{"B", "C"}},
+		{UserID: "c", Ranks: []string{"C", "B"}},
+	}
+
+	winners, tieBreak, err := CalculateSequentialIRVWinners(movies("A", "B", "C"), ballots, 2)
+	if err != nil {
+		t.Fatalf("CalculateSequentialIRVWinners: %v", err)
+	}
+	if got := movieIDs(winners); len(got) != 2 || got[0] != "A" || got[1] != "C" {
+		t.Errorf("winners = %v, want [A C]", got)
+	}
+	if tieBreak != TieBreakNominationOrder {
+		t.Errorf("tie break = %q, want %q", tieBreak, TieBreakNominationOrder)
+	}
+}
+
+func TestSequentialIRVTieForLastSeat(t *testing.T) {
+	// A wins the first seat outright; B and C tie for the second
+	ballots := []Ballot{
+		{UserID: "a", Ranks: []string{"A"}},
+		{UserID: "b", Ranks: []string{"A"}},
+		{UserID: "c", Ranks: []string{"A"}},
+		{UserID: "d", Ranks: []string{"B"}},
+		{UserID: "e", Ranks: []string{"C"}},
+	}
+
+	winners, _, err := CalculateSequentialIRVWinners(movies("A", "B", "C"), ballots, 2)
+	var tie *TieError
+	if !errors.As(err, &tie) {
+		t.Fatalf("error = %v, want a *TieError", err)
+	}
+	if got := movieIDs(tie.Movies); len(got) != 2 || got[0] != "B" || got[1] != "C" {
+		t.Errorf("tied movies = %v, want [B C]", got)
+	}
+	if got := movieIDs(winners); len(got) != 1 || got[0] != "A" {
+		t.Errorf("winners = %v, want [A] already elected", got)
+	}
+}
+
+func TestFinishRankingSettlesLastSeatTie(t *testing.T) {
+	newParty := func(runoffOnTie bool) *Party {
+		return &Party{
+			Phase: PhaseRanking,
+			Participants: map[string]*Participant{
+				"a": {ID: "a", IsHost: true}, "b": {ID: "b"}, "c": {ID: "c"}, "d": {ID: "d"}, "e": {ID: "e"},
+			},
+			Settings:       PartySettings{WinnerCount: 2, RunoffOnTie: runoffOnTie},
+			NominationPool: movies("A", "B", "C"),
+			Submissions: map[string][]string{
+				"a": {"A"}, "b": {"A"}, "c": {"A"}, "d": {"B"}, "e": {"C"},
+			},
+		}
+	}
+
+	t.Run("nomination order", func(t *testing.T) {
+		party := newParty(false)
+		if err := finishRanking(context.Background(), party, ClosedByAllVoted); err != nil {
+			t.Fatalf("finishRanking: %v", err)
+		}
+		if got := movieIDs(party.Winners); len(got) != 2 || got[0] != "A" || got[1] != "B" {
+			t.Errorf("winners = %v, want [A B]", got)
+		}
+		if party.Results.TieBreak != TieBreakNominationOrder {
+			t.Errorf("tie break = %q, want %q", party.Results.TieBreak, TieBreakNominationOrder)
+		}
+	})
+
+	t.Run("runoff", func(t *testing.T) {
+		party := newParty(true)
+		if err := finishRanking(context.Background(), party, ClosedByAllVoted); err != nil {
+			t.Fatalf("finishRanking: %v", err)
+		}
+		if party.Phase != PhaseRunoff || party.Runoff == nil {
+			t.Fatalf("phase = %s, want a runoff", party.Phase)
+		}
+		if got := movieIDs(party.Runoff.Movies); len(got) != 2 || got[0] != "B" || got[1] != "C" {
+			t.Errorf("runoff movies = %v, want [B C]", got)
+		}
+
+		party.Runoff.Votes = map[string]string{"a": "C", "b": "C", "c": "B"}
+		resolveRunoff(party)
+		if got := movieIDs(party.Winners); len(got) != 2 || got[0] != "A" || got[1] != "C" {
+			t.Errorf("winners = %v, want [A C]", got)
+		}
+		if party.Results.TieBreak != TieBreakRunoff {
+			t.Errorf("tie break = %q, want %q", party.Results.TieBreak, TieBreakRunoff)
+		}
+	})
+}
+
+// repeat returns n copies of a ballot ranking the given movies
+func repeat(n int, ranks ...string) []Ballot {
+	ballots := make([]Ballot, n)
+	for i := range ballots {
+		ballots[i] = Ballot{UserID: fmt.Sprintf("%s-%d", strings.Join(ranks, ""), i), Ranks: ranks}
+	}
+	return ballots
+}
+
+// concat joins groups of ballots
+func concat(groups ...[]Ballot) []Ballot {
+	var ballots []Ballot
+	for _, group := range groups {
+		ballots = append(ballots, group...)
+	}
+	return ballots
+}
+
+func TestCalculateSTVWinners(t *testing.T) {
+	tests := []struct {
+		name    string
+		pool    []Movie
+		ballots []Ballot
+		seats   int
+		want    []string
+	}{
+		{
+			// Quota 10/3: A's surplus of 3.67 moves on to B at 0.52 per
+			// ballot, lifting B over the quota ahead of D
+			name:    "surplus transfers elect the next choice",
+			pool:    movies("A", "B", "C", "D"),
+			ballots: concat(repeat(7, "A", "B"), repeat(1, "C"), repeat(2, "D")),
+			seats:   2,
+			want:    []string{"A", "B"},
+		},
+		{
+			// Quota 3: A is elected, then nobody reaches the quota. D and
+			// then B are eliminated and their votes carry C past it.
+			name:    "eliminations transfer votes when no one reaches the quota",
+			pool:    movies("A", "B", "C", "D"),
+			ballots: concat(repeat(4, "A"), repeat(2, "B", "C"), repeat(2, "C"), repeat(1, "D", "C")),
+			seats:   2,
+			want:    []string{"A", "C"},
+		},
+		{
+			name:    "remaining movies fill the seats",
+			pool:    movies("A", "B", "C"),
+			ballots: concat(repeat(2, "A"), repeat(1, "B"), repeat(1, "C")),
+			seats:   3,
+			want:    []string{"A", "B", "C"},
+		},
+		{
+			name:    "seats are capped at the pool size",
+			pool:    movies("A", "B"),
+			ballots: repeat(1, "B", "A"),
+			seats:   3,
+			want:    []string{"B", "A"},
+		},
+		{
+			// Quota 5/3: the weight 3 ballot elects A; its surplus of 1.33
+			// moves on to C, which then beats B
+			name: "weighted ballots",
+			pool: movies("A", "B", "C"),
+			ballots: []Ballot{
+				{UserID: "x", Ranks: []string{"A", "C"}, Weight: 3},
+				{UserID: "y", Ranks: []string{"B"}},
+				{UserID: "z", Ranks: []string{"C"}},
+			},
+			seats: 2,
+			want:  []string{"A", "C"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			winners, err := CalculateSTVWinners(tt.pool, tt.ballots, tt.seats)
+			if err != nil {
+				t.Fatalf("CalculateSTVWinners: %v", err)
+			}
+			if got := movieIDs(winners); !slices.Equal(got, tt.want) {
+				t.Errorf("winners = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateSTVWinnersErrors(t *testing.T) {
+	if _, err := CalculateSTVWinners(nil, repeat(1, "A"), 2); err == nil {
+		t.Error("empty pool returned nil, want an error")
+	}
+	if _, err := CalculateSTVWinners(movies("A", "B"), nil, 2); err == nil {
+		t.Error("no ballots returned nil, want an error")
+	}
+}
+
+func TestCalculateSequentialIRVWinners(t *testing.T) {
+	tests := []struct {
+		name    string
+		pool    []Movie
+		ballots []Ballot
+		seats   int
+		want    []string
+	}{
+		{
+			// A wins outright; without A, its ballots move on to B
+			name:    "later seats exclude earlier winners",
+			pool:    movies("A", "B", "C"),
+			ballots: concat(repeat(4, "A", "B"), repeat(2, "B", "C"), repeat(1, "C", "B")),
+			seats:   2,
+			want:    []string{"A", "B"},
+		},
+		{
+			// C leads on first choices but loses both runoffs: B's votes
+			// carry A for the first seat and A's carry B for the second
+			name:    "each seat is an instant runoff",
+			pool:    movies("A", "B", "C"),
+			ballots: concat(repeat(4, "C"), repeat(3, "A", "B"), repeat(2, "B", "A")),
+			seats:   2,
+			want:    []string{"A", "B"},
+		},
+		{
+			name:    "seats are capped at the pool size",
+			pool:    movies("A", "B"),
+			ballots: repeat(1, "B", "A"),
+			seats:   3,
+			want:    []string{"B", "A"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			winners, tieBreak, err := CalculateSequentialIRVWinners(tt.pool, tt.ballots, tt.seats)
+			if err != nil {
+				t.Fatalf("CalculateSequentialIRVWinners: %v", err)
+			}
+			if got := movieIDs(winners); !slices.Equal(got, tt.want) {
+				t.Errorf("winners = %v, want %v", got, tt.want)
+			}
+			if tieBreak != "" {
+				t.Errorf("tie break = %q, want none", tieBreak)
+			}
+		})
+	}
+}
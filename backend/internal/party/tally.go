@@ -0,0 +1,115 @@
+package party
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+)
+
+// Ballot is one participant's submission as counted by the voting methods
+type Ballot struct {
+	UserID    string
+	Ranks     []string // Movie IDs in order of preference, possibly truncated
+	NoOpinion []string // Movies the participant explicitly declined to rank
+	Weight    float64  // How much the ballot counts, 0 meaning one
+}
+
+// weight returns how much the ballot counts
+func (b Ballot) weight() float64 {
+	if b.Weight == 0 {
+		return DefaultVoterWeight
+	}
+	return b.Weight
+}
+
+// Ordering methods recorded in the results
+const (
+	OrderingIRV           = "irv"            // Single instant-runoff winner
+	OrderingSequentialIRV = "sequential_irv" // Repeated instant runoff, excluding earlier winners
+	OrderingSTV           = "stv"            // Single transferable vote, in order of election
+	OrderingBorda         = "borda"          // Borda points, highest first
+)
+
+// Tally determines up to seats winning movies using the given voting method.
+// It returns the winners in watch order, the ordering method used and how
+// any exact tie was broken along the way, one of the TieBreak* constants. An
+// instant runoff that ends in an exact tie for the last seat returns a
+// *TieError along with the winners already elected.
+func Tally(method string, seats int, pool []Movie, ballots []Ballot) ([]Movie, string, string, error) {
+	if seats < 1 {
+		seats = 1
+	}
+
+	switch {
+	case method == VotingMethodBorda:
+		winners, err := CalculateBordaWinners(pool, ballots, seats)
+		return winners, OrderingBorda, "", err
+	case method == VotingMethodSTV && seats > 1:
+		winners, err := CalculateSTVWinners(pool, ballots, seats)
+		return winners, OrderingSTV, "", err
+	case seats > 1:
+		winners, tieBreak, err := CalculateSequentialIRVWinners(pool, ballots, seats)
+		return winners, OrderingSequentialIRV, tieBreak, err
+	default:
+		// With a single seat STV is the same as instant runoff
+		winner, err := CalculateWinner(pool, ballots)
+		if err != nil {
+			return nil, OrderingIRV, "", err
+		}
+		return []Movie{*winner}, OrderingIRV, "", nil
+	}
+}
+
+// CalculateBordaWinners implements the Borda count. With n nominated movies,
+// a ballot gives n-1 points to its first choice, n-2 to its second and so on.
+// Movies marked "no opinion" share the points of the positions below the
+// ranked movies equally; unmentioned movies get no points. Points are scaled
+// by the ballot's weight. The movies with the most points fill the seats,
+// highest first, with ties going to the movie nominated first.
+func CalculateBordaWinners(pool []Movie, ballots []Ballot, seats int) ([]Movie, error) {
+	if len(pool) == 0 {
+		return nil, fmt.Errorf("no movies in nomination pool")
+	}
+
+	if len(ballots) == 0 {
+		return nil, fmt.Errorf("no voting submissions received")
+	}
+
+	points := make(map[string]float64)
+	for _, movie := range pool {
+		points[movie.ID] = 0
+	}
+
+	n := float64(len(pool))
+	for _, ballot := range ballots {
+		weight := ballot.weight()
+		for position, movieID := range ballot.Ranks {
+			if _, exists := points[movieID]; exists {
+				points[movieID] += (n - 1 - float64(position)) * weight
+			}
+		}
+
+		// Average of the points for the next len(NoOpinion) positions
+		if len(ballot.NoOpinion) > 0 {
+			first := float64(len(ballot.Ranks))
+			last := first + float64(len(ballot.NoOpinion)) - 1
+			shared := n - 1 - (first+last)/2
+			for _, movieID := range ballot.NoOpinion {
+				if _, exists := points[movieID]; exists {
+					points[movieID] += shared * weight
+				}
+			}
+		}
+	}
+
+	ordered := append([]Movie(nil), pool...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return points[ordered[i].ID] > points[ordered[j].ID]
+	})
+	if seats < len(ordered) {
+		ordered = ordered[:seats]
+	}
+
+	slog.Debug("Borda winner", "movie_id", ordered[0].ID)
+	return ordered, nil
+}
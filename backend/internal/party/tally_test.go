@@ -0,0 +1,85 @@
+package party
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCalculateBordaWinners(t *testing.T) {
+	tests := []struct {
+		name    string
+		pool    []Movie
+		ballots []Ballot
+		seats   int
+		want    []string
+	}{
+		{
+			// C: 2+2+0, A: 0+0+2, B: 0+0+1
+			name:    "full and partial ballots",
+			pool:    movies("A", "B", "C"),
+			ballots: concat(repeat(2, "C"), repeat(1, "A", "B", "C")),
+			seats:   3,
+			want:    []string{"C", "A", "B"},
+		},
+		{
+			name:    "seats take the highest scores",
+			pool:    movies("A", "B", "C"),
+			ballots: concat(repeat(2, "C"), repeat(1, "A", "B", "C")),
+			seats:   1,
+			want:    []string{"C"},
+		},
+		{
+			// A and B both score 3; A was nominated first
+			name:    "ties go to the earlier nomination",
+			pool:    movies("A", "B", "C"),
+			ballots: concat(repeat(1, "B", "A", "C"), repeat(1, "A", "B", "C")),
+			seats:   2,
+			want:    []string{"A", "B"},
+		},
+		{
+			// B and C share positions two and three for 1.5 points each,
+			// leaving B ahead of the unmentioned D
+			name: "no opinion shares the remaining positions",
+			pool: movies("A", "B", "C", "D"),
+			ballots: []Ballot{
+				{UserID: "a", Ranks: []string{"A"}, NoOpinion: []string{"B", "C"}},
+				{UserID: "b", Ranks: []string{"C"}},
+			},
+			seats: 4,
+			want:  []string{"C", "A", "B", "D"},
+		},
+		{
+			// A: 2*3, B: 2+2
+			name: "points are scaled by weight",
+			pool: movies("A", "B", "C"),
+			ballots: []Ballot{
+				{UserID: "a", Ranks: []string{"A"}, Weight: 3},
+				{UserID: "b", Ranks: []string{"B"}},
+				{UserID: "c", Ranks: []string{"B"}},
+			},
+			seats: 2,
+			want:  []string{"A", "B"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			winners, err := CalculateBordaWinners(tt.pool, tt.ballots, tt.seats)
+			if err != nil {
+				t.Fatalf("CalculateBordaWinners: %v", err)
+			}
+			if got := movieIDs(winners); !slices.Equal(got, tt.want) {
+				t.Errorf("winners = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateBordaWinnersErrors(t *testing.T) {
+	if _, err := CalculateBordaWinners(nil, repeat(1, "A"), 1); err == nil {
+		t.Error("empty pool returned nil, want an error")
+	}
+	if _, err := CalculateBordaWinners(movies("A", "B"), nil, 1); err == nil {
+		t.Error("no ballots returned nil, want an error")
+	}
+}
@@ -0,0 +1,210 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/reelchoice/backend/internal/logging"
+	"github.com/reelchoice/backend/internal/metrics"
+	"github.com/reelchoice/backend/internal/party"
+	"golang.org/x/sync/singleflight"
+)
+
+// Cache interface for the Redis operations needed by the client
+type Cache interface {
+	GetCachedTMDBData(ctx context.Context, namespace, key string) ([]byte, error)
+	SetCachedTMDBData(ctx context.Context, namespace, key string, data []byte) error
+	GetStaleTMDBData(ctx context.Context, namespace, key string) ([]byte, error)
+	SetStaleTMDBData(ctx context.Context, namespace, key string, data []byte) error
+	AcquireTMDBCacheLock(ctx context.Context, namespace, key string) (bool, error)
+	ReleaseTMDBCacheLock(ctx context.Context, namespace, key string) error
+}
+
+// How long an instance waits for another instance to fill a locked cache entry
+const (
+	lockWaitTimeout  = 2 * time.Second
+	lockPollInterval = 100 * time.Millisecond
+)
+
+// fillTimeout bounds a shared cache fill, which outlives the caller that
+// started it
+const fillTimeout = 15 * time.Second
+
+// CacheStats counts cache outcomes for a client
+type CacheStats struct {
+	Hits      atomic.Int64 // Served from the fresh cache, including entries filled by another instance
+	Misses    atomic.Int64 // Required a TMDB request
+	Coalesced atomic.Int64 // Shared an in-flight request from the same instance
+	StaleHits atomic.Int64 // Served a stale copy because TMDB was unavailable
+}
+
+// Stats returns the client's cache counters
+func (c *Client) Stats() *CacheStats {
+	return &c.stats
+}
+
+// fetchCached returns the cached value for key, or calls fetch and caches its
+// result.
+//
+// Concurrent misses for the same key are coalesced: within an instance via
+// singleflight, and across instances via a short Redis lock whose holder
+// fills the cache while the others wait for it. A longer-lived stale copy is
+// kept alongside each entry and served when TMDB is rate limiting, failing or
+// behind an open circuit breaker.
+//
+// The fill is shared by every coalesced caller, so fetch gets a context that
+// is not canceled with the caller's; each caller still stops waiting when its
+// own context is done.
+func fetchCached[T any](ctx context.Context, c *Client, namespace, key string, fetch func(ctx context.Context) (*T, error)) (*T, error) {
+	// Check cache first
+	if data := c.readCache(ctx, namespace, key); data != nil {
+		var value T
+		if err := json.Unmarshal(data, &value); err == nil {
+			c.stats.Hits.Add(1)
+			metrics.TMDBCacheLookups.WithLabelValues(metrics.CacheHit).Inc()
+			return &value, nil
+		}
+	}
+
+	// Each caller decodes its own copy of the shared response
+	fill := c.fills.DoChan(namespace+":"+key, func() (interface{}, error) {
+		fillCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), fillTimeout)
+		defer cancel()
+
+		return c.fillCache(fillCtx, namespace, key, func() ([]byte, error) {
+			value, err := fetch(fillCtx)
+			if err != nil {
+				return nil, err
+			}
+			return json.Marshal(value)
+		})
+	})
+
+	var res singleflight.Result
+	select {
+	case res = <-fill:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	result, err, shared := res.Val, res.Err, res.Shared
+	if shared {
+		c.stats.Coalesced.Add(1)
+		metrics.TMDBCacheLookups.WithLabelValues(metrics.CacheCoalesced).Inc()
+	}
+
+	if err != nil {
+		if isTransient(err) || errors.Is(err, ErrCircuitOpen) {
+			staleData, staleErr := c.redisClient.GetStaleTMDBData(ctx, namespace, key)
+			if staleErr == nil && staleData != nil {
+				var stale T
+				if json.Unmarshal(staleData, &stale) == nil {
+					c.stats.StaleHits.Add(1)
+					metrics.TMDBCacheLookups.WithLabelValues(metrics.CacheStale).Inc()
+					logging.FromContext(ctx).Warn("Serving stale TMDB data", "key", key, "error", err)
+					return &stale, nil
+				}
+			}
+		}
+		return nil, err
+	}
+
+	var value T
+	if err := json.Unmarshal(result.([]byte), &value); err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// fillCache fetches and caches an entry unless another instance is already
+// doing so, in which case it waits briefly for that instance's result
+func (c *Client) fillCache(ctx context.Context, namespace, key string, fetch func() ([]byte, error)) ([]byte, error) {
+	acquired, err := c.redisClient.AcquireTMDBCacheLock(ctx, namespace, key)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Failed to acquire TMDB cache lock", "key", key, "error", err)
+	}
+
+	if acquired {
+		defer func() {
+			if err := c.redisClient.ReleaseTMDBCacheLock(ctx, namespace, key); err != nil {
+				logging.FromContext(ctx).Warn("Failed to release TMDB cache lock", "key", key, "error", err)
+			}
+		}()
+	} else if err == nil {
+		if data := c.waitForFill(ctx, namespace, key); data != nil {
+			c.stats.Hits.Add(1)
+			metrics.TMDBCacheLookups.WithLabelValues(metrics.CacheHit).Inc()
+			return data, nil
+		}
+	}
+
+	c.stats.Misses.Add(1)
+	metrics.TMDBCacheLookups.WithLabelValues(metrics.CacheMiss).Inc()
+	data, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	// Cache the result
+	c.redisClient.SetCachedTMDBData(ctx, namespace, key, data)
+	c.redisClient.SetStaleTMDBData(ctx, namespace, key, data)
+
+	return data, nil
+}
+
+// waitForFill polls the cache while another instance fills it, returning nil
+// if the entry does not appear in time
+func (c *Client) waitForFill(ctx context.Context, namespace, key string) []byte {
+	ticker := time.NewTicker(lockPollInterval)
+	defer ticker.Stop()
+
+	timeout := time.NewTimer(lockWaitTimeout)
+	defer timeout.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timeout.C:
+			return nil
+		case <-ticker.C:
+			if data := c.readCache(ctx, namespace, key); data != nil {
+				return data
+			}
+		}
+	}
+}
+
+// readCache returns a fresh cache entry, or nil on a miss or Redis error
+func (c *Client) readCache(ctx context.Context, namespace, key string) []byte {
+	data, err := c.redisClient.GetCachedTMDBData(ctx, namespace, key)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Failed to read TMDB cache", "key", key, "error", err)
+		return nil
+	}
+	return data
+}
+
+// normalizeSearch canonicalizes a search so that equivalent requests produce
+// the same cache key: queries are trimmed, lowercased and have their internal
+// whitespace collapsed, and language and region codes are normalized
+func normalizeSearch(query string, opts party.SearchOptions) (string, party.SearchOptions) {
+	query = strings.ToLower(strings.Join(strings.Fields(query), " "))
+
+	if opts.Page < 1 {
+		opts.Page = 1
+	}
+
+	// Languages are "en" or "en-US"
+	if language, region, found := strings.Cut(opts.Language, "-"); found {
+		opts.Language = strings.ToLower(language) + "-" + strings.ToUpper(region)
+	} else {
+		opts.Language = strings.ToLower(opts.Language)
+	}
+	opts.Region = strings.ToUpper(opts.Region)
+
+	return query, opts
+}
@@ -0,0 +1,106 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryCache is an in-memory Cache
+type memoryCache struct {
+	mutex sync.Mutex
+	data  map[string][]byte
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{data: make(map[string][]byte)}
+}
+
+func (m *memoryCache) get(key string) ([]byte, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.data[key], nil
+}
+
+func (m *memoryCache) set(key string, data []byte) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.data[key] = data
+	return nil
+}
+
+func (m *memoryCache) GetCachedTMDBData(ctx context.Context, namespace, key string) ([]byte, error) {
+	return m.get("fresh:" + namespace + ":" + key)
+}
+
+func (m *memoryCache) SetCachedTMDBData(ctx context.Context, namespace, key string, data []byte) error {
+	return m.set("fresh:"+namespace+":"+key, data)
+}
+
+func (m *memoryCache) GetStaleTMDBData(ctx context.Context, namespace, key string) ([]byte, error) {
+	return m.get("stale:" + namespace + ":" + key)
+}
+
+func (m *memoryCache) SetStaleTMDBData(ctx context.Context, namespace, key string, data []byte) error {
+	return m.set("stale:"+namespace+":"+key, data)
+}
+
+func (m *memoryCache) AcquireTMDBCacheLock(ctx context.Context, namespace, key string) (bool, error) {
+	return true, nil
+}
+
+func (m *memoryCache) ReleaseTMDBCacheLock(ctx context.Context, namespace, key string) error {
+	return nil
+}
+
+func TestFetchCachedSurvivesFirstCallerCancel(t *testing.T) {
+	client := NewClient("test-key", newMemoryCache())
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) (*string, error) {
+		close(started)
+		select {
+		case <-release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		value := "The Matrix"
+		return &value, nil
+	}
+
+	// The first caller starts the fill, then goes away
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := fetchCached(firstCtx, client, "details", "603", fetch)
+		firstErr <- err
+	}()
+	<-started
+
+	secondResult := make(chan *string, 1)
+	secondErr := make(chan error, 1)
+	go func() {
+		value, err := fetchCached(context.Background(), client, "details", "603", fetch)
+		secondResult <- value
+		secondErr <- err
+	}()
+
+	cancelFirst()
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("first caller error = %v, want context.Canceled", err)
+	}
+
+	// Give the second caller time to join the in-flight fill
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+
+	if err := <-secondErr; err != nil {
+		t.Fatalf("second caller error = %v, want the shared result", err)
+	}
+	if value := <-secondResult; value == nil || *value != "The Matrix" {
+		t.Errorf("second caller value = %v, want The Matrix", value)
+	}
+}
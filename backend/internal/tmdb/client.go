@@ -3,43 +3,112 @@ package tmdb
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
 	"time"
 
 	"github.com/reelchoice/backend/internal/database"
+	"github.com/reelchoice/backend/internal/metrics"
 	"github.com/reelchoice/backend/internal/party"
+	"github.com/reelchoice/backend/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/sync/singleflight"
 )
 
-// Client represents a TMDB API client
+// Client represents a TMDB API client. Requests are rate limited, retried
+// with jittered backoff on transient failures, and guarded by a circuit
+// breaker; while TMDB is unavailable, stale cached responses are served.
 type Client struct {
 	apiKey      string
-	redisClient *database.RedisClient
+	redisClient Cache
 	httpClient  *http.Client
 	baseURL     string
+	limiter     *rateLimiter
+	breaker     *circuitBreaker
+	fills       singleflight.Group
+	stats       CacheStats
 }
 
-// TMDBMovieResult represents a movie result from TMDB API
+// TMDBMovieResult represents a movie result from TMDB API. Results from
+// /search/tv and /search/multi use Name and FirstAirDate for TV shows and
+// tag each entry with its MediaType.
 type TMDBMovieResult struct {
-	ID          int     `json:"id"`
-	Title       string  `json:"title"`
-	ReleaseDate string  `json:"release_date"`
-	PosterPath  string  `json:"poster_path"`
-	Overview    string  `json:"overview"`
-	VoteAverage float64 `json:"vote_average"`
+	ID           int     `json:"id"`
+	Title        string  `json:"title"`
+	Name         string  `json:"name"`
+	MediaType    string  `json:"media_type"`
+	ReleaseDate  string  `json:"release_date"`
+	FirstAirDate string  `json:"first_air_date"`
+	PosterPath   string  `json:"poster_path"`
+	Overview     string  `json:"overview"`
+	VoteAverage  float64 `json:"vote_average"`
 }
 
+// TMDBMovieDetails represents the movie details response from TMDB, including
+// the credits, videos and release dates requested via append_to_response
+type TMDBMovieDetails struct {
+	TMDBMovieResult
+	Runtime      int         `json:"runtime"`
+	Genres       []TMDBGenre `json:"genres"`
+	Credits      TMDBCredits `json:"credits"`
+	Videos       TMDBVideos  `json:"videos"`
+	ReleaseDates struct {
+		Results []struct {
+			Country      string `json:"iso_3166_1"`
+			ReleaseDates []struct {
+				Certification string `json:"certification"`
+			} `json:"release_dates"`
+		} `json:"results"`
+	} `json:"release_dates"`
+}
+
+// TMDBGenre represents a genre attached to a movie or TV series
+type TMDBGenre struct {
+	Name string `json:"name"`
+}
+
+// TMDBCredits represents the cast and crew of a movie, series or episode
+type TMDBCredits struct {
+	Cast []struct {
+		Name      string `json:"name"`
+		Character string `json:"character"`
+	} `json:"cast"`
+	Crew []struct {
+		Name string `json:"name"`
+		Job  string `json:"job"`
+	} `json:"crew"`
+}
+
+// TMDBVideos represents the videos attached to a movie or TV series
+type TMDBVideos struct {
+	Results []struct {
+		Key      string `json:"key"`
+		Site     string `json:"site"`
+		Type     string `json:"type"`
+		Official bool   `json:"official"`
+	} `json:"results"`
+}
+
+// Limits and defaults for movie details
+const (
+	maxCastMembers              = 5
+	defaultCertificationCountry = "US"
+)
+
 // TMDBSearchResponse represents the search response from TMDB
 type TMDBSearchResponse struct {
-	Page    int               `json:"page"`
-	Results []TMDBMovieResult `json:"results"`
-	Total   int               `json:"total_results"`
+	Page       int               `json:"page"`
+	Results    []TMDBMovieResult `json:"results"`
+	Total      int               `json:"total_results"`
+	TotalPages int               `json:"total_pages"`
 }
 
 // NewClient creates a new TMDB client
-func NewClient(apiKey string, redisClient *database.RedisClient) *Client {
+func NewClient(apiKey string, redisClient Cache) *Client {
 	return &Client{
 		apiKey:      apiKey,
 		redisClient: redisClient,
@@ -47,160 +116,443 @@ func NewClient(apiKey string, redisClient *database.RedisClient) *Client {
 			Timeout: 10 * time.Second,
 		},
 		baseURL: "https://api.themoviedb.org/3",
+		limiter: newRateLimiter(defaultRequestsPerSecond, defaultBurst),
+		breaker: &circuitBreaker{},
 	}
 }
 
+// SetBaseURL points the client at a different API root, such as a test server
+func (c *Client) SetBaseURL(baseURL string) {
+	c.baseURL = baseURL
+}
+
+// SetHTTPClient replaces the HTTP client used for requests
+func (c *Client) SetHTTPClient(httpClient *http.Client) {
+	c.httpClient = httpClient
+}
+
+// SetRateLimit replaces the request rate limit
+func (c *Client) SetRateLimit(requestsPerSecond float64, burst int) {
+	c.limiter = newRateLimiter(requestsPerSecond, burst)
+}
+
 // SearchMovies searches for movies using the TMDB API
-func (c *Client) SearchMovies(ctx context.Context, query string) ([]party.Movie, error) {
+func (c *Client) SearchMovies(ctx context.Context, query string, opts party.SearchOptions) (*party.SearchResult, error) {
 	if query == "" {
-		return nil, fmt.Errorf("search query cannot be empty")
+		return nil, party.ErrInvalidRequest.WithMessage("search query cannot be empty")
 	}
 
-	// Check cache first
-	cachedData, err := c.redisClient.GetCachedTMDBData(ctx, query)
-	if err == nil && cachedData != nil {
-		var movies []party.Movie
-		if err := json.Unmarshal(cachedData, &movies); err == nil {
-			return movies, nil
-		}
+	// Build search parameters from normalized input so that equivalent searches
+	// ("Alien", "alien ", "ALIEN") share a cache entry; the endpoint and encoded
+	// parameters double as the cache key
+	query, opts = normalizeSearch(query, opts)
+	if query == "" {
+		return nil, party.ErrInvalidRequest.WithMessage("search query cannot be empty")
 	}
 
-	// Build search URL
-	searchURL := fmt.Sprintf("%s/search/movie", c.baseURL)
+	path := searchPath(opts.MediaType)
+	params := searchParams(query, opts)
+	cacheKey := path + "?" + params.Encode()
+
+	return fetchCached(ctx, c, database.TMDBSearchNamespace, cacheKey, func(ctx context.Context) (*party.SearchResult, error) {
+		// Make HTTP request
+		var searchResponse TMDBSearchResponse
+		if err := c.getJSON(ctx, path, params, &searchResponse); err != nil {
+			return nil, err
+		}
+
+		// Convert to our movie format, skipping people returned by multi search
+		movies := make([]party.Movie, 0, len(searchResponse.Results))
+		for _, result := range searchResponse.Results {
+			mediaType := result.MediaType
+			if mediaType == "" {
+				mediaType = opts.MediaType
+			}
+
+			switch mediaType {
+			case "", party.ContentTypeMovie:
+				movies = append(movies, result.toMovie())
+			case party.ContentTypeTV:
+				movies = append(movies, result.toTVShow())
+			}
+		}
+
+		return &party.SearchResult{
+			Movies:       movies,
+			Page:         searchResponse.Page,
+			TotalResults: searchResponse.Total,
+			TotalPages:   searchResponse.TotalPages,
+		}, nil
+	})
+}
+
+// searchParams builds the TMDB query parameters for a search, without the API key
+func searchParams(query string, opts party.SearchOptions) url.Values {
 	params := url.Values{}
-	params.Set("api_key", c.apiKey)
 	params.Set("query", query)
-	params.Set("page", "1")
-	params.Set("include_adult", "false")
 
-	fullURL := fmt.Sprintf("%s?%s", searchURL, params.Encode())
+	page := opts.Page
+	if page < 1 {
+		page = 1
+	}
+	params.Set("page", strconv.Itoa(page))
+	params.Set("include_adult", strconv.FormatBool(opts.IncludeAdult))
+
+	// Year filters are named differently per endpoint and unsupported by multi search
+	switch opts.MediaType {
+	case "", party.ContentTypeMovie:
+		if opts.Year != 0 {
+			params.Set("year", strconv.Itoa(opts.Year))
+		}
+		if opts.PrimaryReleaseYear != 0 {
+			params.Set("primary_release_year", strconv.Itoa(opts.PrimaryReleaseYear))
+		}
+	case party.ContentTypeTV:
+		if opts.Year != 0 {
+			params.Set("year", strconv.Itoa(opts.Year))
+		}
+		if opts.PrimaryReleaseYear != 0 {
+			params.Set("first_air_date_year", strconv.Itoa(opts.PrimaryReleaseYear))
+		}
+	}
+	if opts.Language != "" {
+		params.Set("language", opts.Language)
+	}
+	if opts.Region != "" {
+		params.Set("region", opts.Region)
+	}
+
+	return params
+}
 
-	// Make HTTP request
-	req, err := http.NewRequestWithContext(ctx, "GET", fullURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+// searchPath returns the TMDB search endpoint for the requested media type
+func searchPath(mediaType string) string {
+	switch mediaType {
+	case party.ContentTypeTV:
+		return "/search/tv"
+	case party.SearchMediaTypeMulti:
+		return "/search/multi"
+	default:
+		return "/search/movie"
 	}
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+// toMovie converts a search result into a movie
+func (r TMDBMovieResult) toMovie() party.Movie {
+	return party.Movie{
+		ID:          strconv.Itoa(r.ID),
+		ContentType: party.ContentTypeMovie,
+		Title:       r.Title,
+		Year:        releaseYear(r.ReleaseDate),
+		PosterPath:  posterURL(r.PosterPath),
+		Overview:    r.Overview,
+		Rating:      r.VoteAverage,
 	}
-	defer resp.Body.Close()
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("TMDB API returned status %d", resp.StatusCode)
+// toTVShow converts a search result into a TV series
+func (r TMDBMovieResult) toTVShow() party.Movie {
+	return party.Movie{
+		ID:          tvShowID(strconv.Itoa(r.ID)),
+		ContentType: party.ContentTypeTV,
+		Title:       r.Name,
+		Year:        releaseYear(r.FirstAirDate),
+		PosterPath:  posterURL(r.PosterPath),
+		Overview:    r.Overview,
+		Rating:      r.VoteAverage,
 	}
+}
 
-	// Parse response
-	var searchResponse TMDBSearchResponse
-	if err := json.NewDecoder(resp.Body).Decode(&searchResponse); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+// releaseYear extracts the year from a TMDB date
+func releaseYear(date string) string {
+	if len(date) >= 4 {
+		return date[:4]
 	}
+	return ""
+}
 
-	// Convert to our movie format
-	movies := make([]party.Movie, 0, len(searchResponse.Results))
-	for _, result := range searchResponse.Results {
-		// Extract year from release date
-		year := ""
-		if result.ReleaseDate != "" && len(result.ReleaseDate) >= 4 {
-			year = result.ReleaseDate[:4]
+// posterURL builds the full image URL for a TMDB poster path
+func posterURL(path string) string {
+	if path == "" {
+		return ""
+	}
+	return fmt.Sprintf("https://image.tmdb.org/t/p/w500%s", path)
+}
+
+// getJSON performs a GET request against the TMDB API and decodes the JSON
+// response into out, retrying transient failures
+func (c *Client) getJSON(ctx context.Context, path string, params url.Values, out interface{}) (err error) {
+	ctx, span := tracing.Start(ctx, "tmdb.getJSON", attribute.String("url.path", path))
+	defer func() {
+		tracing.RecordError(ctx, err)
+		span.End()
+	}()
+
+	fullURL := c.requestURL(path, params)
+
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return err
 		}
 
-		// Build poster URL
-		posterPath := ""
-		if result.PosterPath != "" {
-			posterPath = fmt.Sprintf("https://image.tmdb.org/t/p/w500%s", result.PosterPath)
+		if !c.breaker.Allow() {
+			return ErrCircuitOpen
 		}
 
-		movie := party.Movie{
-			ID:         strconv.Itoa(result.ID),
-			Title:      result.Title,
-			Year:       year,
-			PosterPath: posterPath,
+		err := c.doRequest(ctx, path, fullURL, attempt, out)
+		c.recordOutcome(ctx, err)
+		if err == nil || !isTransient(err) {
+			return err
+		}
+		lastErr = err
+
+		if attempt == maxAttempts {
+			break
+		}
+
+		var retryAfter time.Duration
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			retryAfter = apiErr.RetryAfter
 		}
-		movies = append(movies, movie)
-	}
 
-	// Cache the result
-	if len(movies) > 0 {
-		movieData, err := json.Marshal(movies)
-		if err == nil {
-			c.redisClient.SetCachedTMDBData(ctx, query, movieData)
+		timer := time.NewTimer(backoff(attempt, retryAfter))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
 		}
 	}
 
-	return movies, nil
+	return lastErr
 }
 
-// GetMovieDetails gets detailed information about a specific movie
-func (c *Client) GetMovieDetails(ctx context.Context, tmdbID string) (*party.Movie, error) {
-	if tmdbID == "" {
-		return nil, fmt.Errorf("movie ID cannot be empty")
+// Ping checks that TMDB is reachable and accepts the API key with a single
+// request for the API configuration. The request goes through the rate
+// limiter and circuit breaker like any other, but is not retried.
+func (c *Client) Ping(ctx context.Context) error {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return err
 	}
 
-	// Check cache first
-	cacheKey := fmt.Sprintf("movie:%s", tmdbID)
-	cachedData, err := c.redisClient.GetCachedTMDBData(ctx, cacheKey)
-	if err == nil && cachedData != nil {
-		var movie party.Movie
-		if err := json.Unmarshal(cachedData, &movie); err == nil {
-			return &movie, nil
-		}
+	if !c.breaker.Allow() {
+		return ErrCircuitOpen
 	}
 
-	// Build movie details URL
-	movieURL := fmt.Sprintf("%s/movie/%s", c.baseURL, tmdbID)
-	params := url.Values{}
-	params.Set("api_key", c.apiKey)
+	const path = "/configuration"
+	var config struct{}
+	err := c.doRequest(ctx, path, c.requestURL(path, nil), 1, &config)
+	c.recordOutcome(ctx, err)
+	return err
+}
+
+// recordOutcome updates the circuit breaker with the result of a request.
+// Requests cut short by their context say nothing about TMDB's health, so
+// they only release the breaker's half-open trial.
+func (c *Client) recordOutcome(ctx context.Context, err error) {
+	if err != nil && ctx.Err() != nil {
+		c.breaker.Release()
+		return
+	}
+	c.breaker.Record(err == nil || !isTransient(err))
+}
+
+// redactURL drops the request URL, which carries the API key, from a
+// url.Error so it stays out of returned errors and spans
+func redactURL(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
+
+// requestURL builds the URL for an API path, adding the API key to params
+func (c *Client) requestURL(path string, params url.Values) string {
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = values
+	}
+	query.Set("api_key", c.apiKey)
+
+	return fmt.Sprintf("%s%s?%s", c.baseURL, path, query.Encode())
+}
 
-	fullURL := fmt.Sprintf("%s?%s", movieURL, params.Encode())
+// doRequest makes a single request and decodes the JSON response into out.
+// The span records path rather than fullURL, which carries the API key.
+func (c *Client) doRequest(ctx context.Context, path, fullURL string, attempt int, out interface{}) (err error) {
+	ctx, span := tracing.StartClient(ctx, "tmdb GET",
+		attribute.String("http.request.method", "GET"),
+		attribute.String("url.path", path),
+		attribute.Int("tmdb.attempt", attempt),
+	)
+	defer func() {
+		tracing.RecordError(ctx, err)
+		span.End()
+	}()
 
-	// Make HTTP request
 	req, err := http.NewRequestWithContext(ctx, "GET", fullURL, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return fmt.Errorf("failed to create request: %w", redactURL(err))
 	}
 
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		metrics.TMDBRequestDuration.WithLabelValues("error").Observe(time.Since(start).Seconds())
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// Network failures are treated like a 5xx so they are retried
+		return fmt.Errorf("%w: failed to execute request: %v", ErrUnavailable, redactURL(err))
 	}
 	defer resp.Body.Close()
+	metrics.TMDBRequestDuration.WithLabelValues(strconv.Itoa(resp.StatusCode)).Observe(time.Since(start).Seconds())
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("TMDB API returned status %d", resp.StatusCode)
+		apiErr := &APIError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+
+		// TMDB error bodies carry a human-readable status_message
+		var body struct {
+			StatusMessage string `json:"status_message"`
+		}
+		if data, err := io.ReadAll(io.LimitReader(resp.Body, 4096)); err == nil {
+			if json.Unmarshal(data, &body) == nil {
+				apiErr.StatusMessage = body.StatusMessage
+			}
+		}
+
+		return apiErr
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// GetMovieDetails gets detailed information about a movie, TV series, season
+// or episode. TV content uses the IDs described in parseContentID.
+func (c *Client) GetMovieDetails(ctx context.Context, tmdbID string) (*party.Movie, error) {
+	if tmdbID == "" {
+		return nil, party.ErrInvalidRequest.WithMessage("movie ID cannot be empty")
 	}
 
-	// Parse response
-	var movieResult TMDBMovieResult
-	if err := json.NewDecoder(resp.Body).Decode(&movieResult); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	contentID, err := parseContentID(tmdbID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Extract year from release date
-	year := ""
-	if movieResult.ReleaseDate != "" && len(movieResult.ReleaseDate) >= 4 {
-		year = movieResult.ReleaseDate[:4]
+	return fetchCached(ctx, c, database.TMDBDetailsNamespace, contentID.String(), func(ctx context.Context) (*party.Movie, error) {
+		switch contentID.contentType {
+		case party.ContentTypeTV:
+			return c.getTVShowDetails(ctx, contentID)
+		case party.ContentTypeSeason:
+			return c.getSeasonDetails(ctx, contentID)
+		case party.ContentTypeEpisode:
+			return c.getEpisodeDetails(ctx, contentID)
+		default:
+			return c.getFeatureDetails(ctx, contentID)
+		}
+	})
+}
+
+// getFeatureDetails fetches a movie along with its credits, videos and release dates
+func (c *Client) getFeatureDetails(ctx context.Context, id contentID) (*party.Movie, error) {
+	params := url.Values{}
+	params.Set("append_to_response", "credits,videos,release_dates")
+
+	var movieResult TMDBMovieDetails
+	if err := c.getJSON(ctx, id.path(), params, &movieResult); err != nil {
+		return nil, err
+	}
+
+	return &party.Movie{
+		ID:            id.String(),
+		ContentType:   party.ContentTypeMovie,
+		Title:         movieResult.Title,
+		Year:          releaseYear(movieResult.ReleaseDate),
+		PosterPath:    posterURL(movieResult.PosterPath),
+		Overview:      movieResult.Overview,
+		Rating:        movieResult.VoteAverage,
+		Runtime:       movieResult.Runtime,
+		Genres:        genreNames(movieResult.Genres),
+		Certification: movieResult.certification(defaultCertificationCountry),
+		Director:      movieResult.Credits.director(),
+		Cast:          movieResult.Credits.topCast(maxCastMembers),
+		TrailerKey:    movieResult.Videos.trailerKey(),
+	}, nil
+}
+
+// genreNames returns the names of the given genres
+func genreNames(tmdbGenres []TMDBGenre) []string {
+	genres := make([]string, 0, len(tmdbGenres))
+	for _, genre := range tmdbGenres {
+		genres = append(genres, genre.Name)
 	}
+	return genres
+}
 
-	// Build poster URL
-	posterPath := ""
-	if movieResult.PosterPath != "" {
-		posterPath = fmt.Sprintf("https://image.tmdb.org/t/p/w500%s", movieResult.PosterPath)
+// certification returns the first non-empty age rating for the given country
+func (d *TMDBMovieDetails) certification(country string) string {
+	for _, result := range d.ReleaseDates.Results {
+		if result.Country != country {
+			continue
+		}
+		for _, release := range result.ReleaseDates {
+			if release.Certification != "" {
+				return release.Certification
+			}
+		}
 	}
+	return ""
+}
 
-	movie := &party.Movie{
-		ID:         tmdbID,
-		Title:      movieResult.Title,
-		Year:       year,
-		PosterPath: posterPath,
+// director returns the name of the director, if credited
+func (c *TMDBCredits) director() string {
+	for _, member := range c.Crew {
+		if member.Job == "Director" {
+			return member.Name
+		}
 	}
+	return ""
+}
 
-	// Cache the result
-	movieData, err := json.Marshal(movie)
-	if err == nil {
-		c.redisClient.SetCachedTMDBData(ctx, cacheKey, movieData)
+// topCast returns up to limit cast members in billing order
+func (c *TMDBCredits) topCast(limit int) []party.CastMember {
+	cast := make([]party.CastMember, 0, limit)
+	for _, member := range c.Cast {
+		if len(cast) == limit {
+			break
+		}
+		cast = append(cast, party.CastMember{
+			Name:      member.Name,
+			Character: member.Character,
+		})
 	}
+	return cast
+}
 
-	return movie, nil
+// trailerKey returns the YouTube key of the trailer, preferring official uploads
+func (v *TMDBVideos) trailerKey() string {
+	fallback := ""
+	for _, video := range v.Results {
+		if video.Site != "YouTube" || video.Type != "Trailer" {
+			continue
+		}
+		if video.Official {
+			return video.Key
+		}
+		if fallback == "" {
+			fallback = video.Key
+		}
+	}
+	return fallback
 }
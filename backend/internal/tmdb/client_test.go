@@ -0,0 +1,239 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// newTestClient returns a client pointed at a test server that answers each
+// request with the status returned by respond, and the number of requests
+// the server has received
+func newTestClient(t *testing.T, respond func(request int, w http.ResponseWriter) int) (*Client, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("api_key") != "test-key" {
+			t.Errorf("api_key = %q, want %q", r.URL.Query().Get("api_key"), "test-key")
+		}
+
+		status := respond(int(requests.Add(1)), w)
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			w.Write([]byte(`{"id": 603}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient("test-key", nil)
+	client.SetBaseURL(server.URL)
+	return client, &requests
+}
+
+// status responds with a fixed status
+func status(code int) func(int, http.ResponseWriter) int {
+	return func(int, http.ResponseWriter) int { return code }
+}
+
+func TestGetJSONHonorsRetryAfter(t *testing.T) {
+	client, requests := newTestClient(t, func(request int, w http.ResponseWriter) int {
+		if request == 1 {
+			w.Header().Set("Retry-After", "1")
+			return http.StatusTooManyRequests
+		}
+		return http.StatusOK
+	})
+
+	var out struct{ ID int }
+	start := time.Now()
+	if err := client.getJSON(context.Background(), "/movie/603", nil, &out); err != nil {
+		t.Fatalf("getJSON: %v", err)
+	}
+
+	if out.ID != 603 {
+		t.Errorf("ID = %d, want 603", out.ID)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %v, want at least the 1s Retry-After", elapsed)
+	}
+}
+
+func TestGetJSONRetriesServerErrors(t *testing.T) {
+	client, requests := newTestClient(t, func(request int, w http.ResponseWriter) int {
+		if request < maxAttempts {
+			return http.StatusServiceUnavailable
+		}
+		return http.StatusOK
+	})
+
+	var out struct{ ID int }
+	if err := client.getJSON(context.Background(), "/movie/603", nil, &out); err != nil {
+		t.Fatalf("getJSON: %v", err)
+	}
+
+	if got := requests.Load(); got != maxAttempts {
+		t.Errorf("requests = %d, want %d", got, maxAttempts)
+	}
+	if client.breaker.failures != 0 {
+		t.Errorf("breaker failures = %d after a success, want 0", client.breaker.failures)
+	}
+}
+
+func TestGetJSONDoesNotRetryClientErrors(t *testing.T) {
+	client, requests := newTestClient(t, status(http.StatusNotFound))
+
+	var out struct{ ID int }
+	err := client.getJSON(context.Background(), "/movie/0", nil, &out)
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("getJSON error = %v, want ErrNotFound", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
+
+func TestCircuitBreakerOpensAfterRepeatedFailures(t *testing.T) {
+	client, requests := newTestClient(t, status(http.StatusInternalServerError))
+
+	var out struct{ ID int }
+	var err error
+	for i := 0; i < 3; i++ {
+		err = client.getJSON(context.Background(), "/movie/603", nil, &out)
+	}
+
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("getJSON error = %v, want ErrCircuitOpen", err)
+	}
+	if got := requests.Load(); got != failureThreshold {
+		t.Errorf("requests = %d, want the breaker to stop them at %d", got, failureThreshold)
+	}
+}
+
+func TestCircuitBreakerHalfOpenRecovery(t *testing.T) {
+	client, requests := newTestClient(t, status(http.StatusOK))
+
+	// Open the breaker, then let the open period pass
+	for i := 0; i < failureThreshold; i++ {
+		client.breaker.Record(false)
+	}
+	if client.breaker.Allow() {
+		t.Fatal("breaker allowed a request while open")
+	}
+	client.breaker.openUntil = time.Now().Add(-time.Millisecond)
+
+	var out struct{ ID int }
+	if err := client.getJSON(context.Background(), "/movie/603", nil, &out); err != nil {
+		t.Fatalf("trial request: %v", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+	if client.breaker.failures != 0 {
+		t.Errorf("breaker failures = %d after a successful trial, want 0", client.breaker.failures)
+	}
+	if !client.breaker.Allow() {
+		t.Error("breaker still rejects requests after recovering")
+	}
+}
+
+func TestCircuitBreakerHalfOpenAllowsOneTrial(t *testing.T) {
+	var breaker circuitBreaker
+	for i := 0; i < failureThreshold; i++ {
+		breaker.Record(false)
+	}
+	breaker.openUntil = time.Now().Add(-time.Millisecond)
+
+	if !breaker.Allow() {
+		t.Fatal("breaker rejected the half-open trial")
+	}
+	if breaker.Allow() {
+		t.Fatal("breaker allowed a second request while the trial is in flight")
+	}
+
+	// A failed trial opens the breaker again
+	breaker.Record(false)
+	if breaker.Allow() {
+		t.Error("breaker allowed a request after the trial failed")
+	}
+}
+
+func TestCanceledRequestsDoNotCountTowardBreaker(t *testing.T) {
+	client, _ := newTestClient(t, status(http.StatusOK))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var out struct{ ID int }
+	for i := 0; i < failureThreshold+1; i++ {
+		if err := client.getJSON(ctx, "/movie/603", nil, &out); !errors.Is(err, context.Canceled) {
+			t.Fatalf("getJSON error = %v, want context.Canceled", err)
+		}
+	}
+
+	if client.breaker.failures != 0 {
+		t.Errorf("breaker failures = %d after canceled requests, want 0", client.breaker.failures)
+	}
+}
+
+func TestRateLimiterSpacesRequests(t *testing.T) {
+	client, requests := newTestClient(t, status(http.StatusOK))
+	client.SetRateLimit(10, 1)
+
+	var out struct{ ID int }
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := client.getJSON(context.Background(), "/movie/603", nil, &out); err != nil {
+			t.Fatalf("getJSON: %v", err)
+		}
+	}
+
+	if got := requests.Load(); got != 3 {
+		t.Errorf("requests = %d, want 3", got)
+	}
+	// One token is available up front; the other two take 100ms each
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("3 requests at 10/s with a burst of 1 took %v, want at least 150ms", elapsed)
+	}
+}
+
+func TestNetworkErrorsOmitAPIKey(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	client := NewClient("SECRET123", nil)
+	client.SetBaseURL("http://127.0.0.1:1")
+
+	err := client.Ping(context.Background())
+	if !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("Ping error = %v, want ErrUnavailable", err)
+	}
+	if strings.Contains(err.Error(), "SECRET123") {
+		t.Errorf("error leaks the API key: %v", err)
+	}
+
+	for _, span := range recorder.Ended() {
+		if strings.Contains(span.Status().Description, "SECRET123") {
+			t.Errorf("span %s status leaks the API key: %s", span.Name(), span.Status().Description)
+		}
+		for _, event := range span.Events() {
+			for _, attr := range event.Attributes {
+				if strings.Contains(attr.Value.Emit(), "SECRET123") {
+					t.Errorf("span %s event leaks the API key: %s", span.Name(), attr.Value.Emit())
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package tmdb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Sentinel errors for TMDB failures. Use errors.Is to check an error returned
+// by the client against them.
+var (
+	ErrNotFound     = errors.New("tmdb: resource not found")
+	ErrUnauthorized = errors.New("tmdb: invalid API key")
+	ErrRateLimited  = errors.New("tmdb: rate limit exceeded")
+	ErrUnavailable  = errors.New("tmdb: service unavailable")
+	ErrCircuitOpen  = errors.New("tmdb: circuit breaker open")
+)
+
+// APIError represents a non-200 response from the TMDB API
+type APIError struct {
+	StatusCode    int
+	StatusMessage string        // TMDB's status_message, if the body contained one
+	RetryAfter    time.Duration // Parsed Retry-After header, zero if absent
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	if e.StatusMessage != "" {
+		return fmt.Sprintf("TMDB API returned status %d: %s", e.StatusCode, e.StatusMessage)
+	}
+	return fmt.Sprintf("TMDB API returned status %d", e.StatusCode)
+}
+
+// Unwrap maps the status code to one of the sentinel errors
+func (e *APIError) Unwrap() error {
+	switch {
+	case e.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case e.StatusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case e.StatusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case e.StatusCode >= 500:
+		return ErrUnavailable
+	default:
+		return nil
+	}
+}
+
+// isTransient reports whether a failed request may succeed if retried
+func isTransient(err error) bool {
+	return errors.Is(err, ErrRateLimited) || errors.Is(err, ErrUnavailable)
+}
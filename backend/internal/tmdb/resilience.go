@@ -0,0 +1,172 @@
+package tmdb
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Retry and circuit breaker settings
+const (
+	maxAttempts      = 3
+	baseBackoff      = 250 * time.Millisecond
+	maxBackoff       = 4 * time.Second
+	maxRetryAfter    = 10 * time.Second
+	failureThreshold = 5
+	openDuration     = 30 * time.Second
+)
+
+// TMDB allows roughly 50 requests per second per IP; stay comfortably below it
+const (
+	defaultRequestsPerSecond = 40
+	defaultBurst             = 20
+)
+
+// rateLimiter is a token bucket shared by all requests made by a client
+type rateLimiter struct {
+	mutex    sync.Mutex
+	rate     float64 // Tokens added per second
+	burst    float64
+	tokens   float64
+	lastFill time.Time
+}
+
+// newRateLimiter creates a full token bucket
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:     requestsPerSecond,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		lastFill: time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or the context is done
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token if one is available, otherwise returns how long to wait
+func (l *rateLimiter) reserve() time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.lastFill).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.lastFill = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// circuitBreaker stops calling TMDB after repeated transient failures
+type circuitBreaker struct {
+	mutex     sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool // A half-open trial request is in flight
+}
+
+// Allow reports whether a request may be attempted. After the open period,
+// a single trial request is let through to probe whether TMDB has recovered.
+func (b *circuitBreaker) Allow() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.failures < failureThreshold {
+		return true
+	}
+
+	if time.Now().Before(b.openUntil) || b.probing {
+		return false
+	}
+
+	b.probing = true
+	return true
+}
+
+// Record updates the breaker with the outcome of a request
+func (b *circuitBreaker) Record(success bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.probing = false
+	if success {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.failures >= failureThreshold {
+		b.openUntil = time.Now().Add(openDuration)
+	}
+}
+
+// Release ends a half-open trial request without recording an outcome
+func (b *circuitBreaker) Release() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.probing = false
+}
+
+// backoff returns a jittered delay before the given retry attempt (1-based),
+// preferring the server's Retry-After hint when one was sent
+func backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		if retryAfter > maxRetryAfter {
+			return maxRetryAfter
+		}
+		return retryAfter
+	}
+
+	ceiling := baseBackoff << (attempt - 1)
+	if ceiling > maxBackoff {
+		ceiling = maxBackoff
+	}
+
+	// Full jitter spreads out retries from clients that failed together
+	return time.Duration(rand.Int63n(int64(ceiling)) + 1)
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,285 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/reelchoice/backend/internal/party"
+)
+
+// TMDBTVDetails represents the TV series details response from TMDB
+type TMDBTVDetails struct {
+	TMDBMovieResult
+	EpisodeRunTime   []int       `json:"episode_run_time"`
+	NumberOfSeasons  int         `json:"number_of_seasons"`
+	NumberOfEpisodes int         `json:"number_of_episodes"`
+	Genres           []TMDBGenre `json:"genres"`
+	Credits          TMDBCredits `json:"credits"`
+	Videos           TMDBVideos  `json:"videos"`
+	CreatedBy        []struct {
+		Name string `json:"name"`
+	} `json:"created_by"`
+	ContentRatings struct {
+		Results []struct {
+			Country string `json:"iso_3166_1"`
+			Rating  string `json:"rating"`
+		} `json:"results"`
+	} `json:"content_ratings"`
+}
+
+// TMDBSeasonDetails represents the season details response from TMDB
+type TMDBSeasonDetails struct {
+	Name         string  `json:"name"`
+	Overview     string  `json:"overview"`
+	AirDate      string  `json:"air_date"`
+	PosterPath   string  `json:"poster_path"`
+	SeasonNumber int     `json:"season_number"`
+	VoteAverage  float64 `json:"vote_average"`
+	Episodes     []struct {
+		Runtime int `json:"runtime"`
+	} `json:"episodes"`
+}
+
+// TMDBEpisodeDetails represents the episode details response from TMDB
+type TMDBEpisodeDetails struct {
+	Name          string      `json:"name"`
+	Overview      string      `json:"overview"`
+	AirDate       string      `json:"air_date"`
+	StillPath     string      `json:"still_path"`
+	Runtime       int         `json:"runtime"`
+	SeasonNumber  int         `json:"season_number"`
+	EpisodeNumber int         `json:"episode_number"`
+	VoteAverage   float64     `json:"vote_average"`
+	Credits       TMDBCredits `json:"credits"`
+}
+
+// contentID identifies a movie or a piece of TV content on TMDB.
+//
+// Movies keep their plain numeric TMDB ID so existing parties stay valid.
+// TV content mirrors the TMDB API paths:
+//
+//	tv/1399                      a TV series
+//	tv/1399/season/1             a season of a series
+//	tv/1399/season/1/episode/2   a single episode
+type contentID struct {
+	contentType string
+	showID      string
+	season      int
+	episode     int
+}
+
+// parseContentID parses a movie or TV content ID
+func parseContentID(id string) (contentID, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) == 1 {
+		if _, err := strconv.Atoi(id); err != nil {
+			return contentID{}, party.ErrInvalidRequest.WithMessage("invalid movie ID: %s", id).WithDetail("movie_id", id)
+		}
+		return contentID{contentType: party.ContentTypeMovie, showID: id}, nil
+	}
+
+	if parts[0] != "tv" || (len(parts) != 2 && len(parts) != 4 && len(parts) != 6) {
+		return contentID{}, party.ErrInvalidRequest.WithMessage("invalid content ID: %s", id).WithDetail("movie_id", id)
+	}
+	if _, err := strconv.Atoi(parts[1]); err != nil {
+		return contentID{}, party.ErrInvalidRequest.WithMessage("invalid content ID: %s", id).WithDetail("movie_id", id)
+	}
+
+	cid := contentID{contentType: party.ContentTypeTV, showID: parts[1]}
+	if len(parts) >= 4 {
+		season, err := strconv.Atoi(parts[3])
+		if parts[2] != "season" || err != nil || season < 0 {
+			return contentID{}, party.ErrInvalidRequest.WithMessage("invalid content ID: %s", id).WithDetail("movie_id", id)
+		}
+		cid.contentType = party.ContentTypeSeason
+		cid.season = season
+	}
+	if len(parts) == 6 {
+		episode, err := strconv.Atoi(parts[5])
+		if parts[4] != "episode" || err != nil || episode < 1 {
+			return contentID{}, party.ErrInvalidRequest.WithMessage("invalid content ID: %s", id).WithDetail("movie_id", id)
+		}
+		cid.contentType = party.ContentTypeEpisode
+		cid.episode = episode
+	}
+
+	return cid, nil
+}
+
+// String formats the content ID in the form accepted by parseContentID
+func (c contentID) String() string {
+	switch c.contentType {
+	case party.ContentTypeTV:
+		return tvShowID(c.showID)
+	case party.ContentTypeSeason:
+		return fmt.Sprintf("tv/%s/season/%d", c.showID, c.season)
+	case party.ContentTypeEpisode:
+		return fmt.Sprintf("tv/%s/season/%d/episode/%d", c.showID, c.season, c.episode)
+	default:
+		return c.showID
+	}
+}
+
+// path returns the TMDB API path for the content
+func (c contentID) path() string {
+	if c.contentType == party.ContentTypeMovie {
+		return "/movie/" + c.showID
+	}
+	return "/" + c.String()
+}
+
+// tvShowID returns the content ID of a TV series
+func tvShowID(showID string) string {
+	return "tv/" + showID
+}
+
+// getTVShowDetails fetches a TV series along with its credits, videos and content ratings
+func (c *Client) getTVShowDetails(ctx context.Context, id contentID) (*party.Movie, error) {
+	params := url.Values{}
+	params.Set("append_to_response", "credits,videos,content_ratings")
+
+	var show TMDBTVDetails
+	if err := c.getJSON(ctx, id.path(), params, &show); err != nil {
+		return nil, err
+	}
+
+	runtime := 0
+	if len(show.EpisodeRunTime) > 0 {
+		runtime = show.EpisodeRunTime[0]
+	}
+
+	// Series have creators rather than a single director
+	creator := ""
+	if len(show.CreatedBy) > 0 {
+		creator = show.CreatedBy[0].Name
+	}
+
+	return &party.Movie{
+		ID:            id.String(),
+		ContentType:   party.ContentTypeTV,
+		Title:         show.Name,
+		Year:          releaseYear(show.FirstAirDate),
+		PosterPath:    posterURL(show.PosterPath),
+		Overview:      show.Overview,
+		Rating:        show.VoteAverage,
+		Runtime:       runtime,
+		Genres:        genreNames(show.Genres),
+		Certification: show.contentRating(defaultCertificationCountry),
+		Director:      creator,
+		Cast:          show.Credits.topCast(maxCastMembers),
+		TrailerKey:    show.Videos.trailerKey(),
+		SeasonCount:   show.NumberOfSeasons,
+		EpisodeCount:  show.NumberOfEpisodes,
+	}, nil
+}
+
+// getSeasonDetails fetches a season and combines it with its series details
+func (c *Client) getSeasonDetails(ctx context.Context, id contentID) (*party.Movie, error) {
+	show, err := c.GetMovieDetails(ctx, tvShowID(id.showID))
+	if err != nil {
+		return nil, err
+	}
+
+	var season TMDBSeasonDetails
+	if err := c.getJSON(ctx, id.path(), nil, &season); err != nil {
+		return nil, err
+	}
+
+	// Runtime of a season is the sum of its episodes
+	runtime := 0
+	for _, episode := range season.Episodes {
+		runtime += episode.Runtime
+	}
+
+	overview := season.Overview
+	if overview == "" {
+		overview = show.Overview
+	}
+
+	posterPath := posterURL(season.PosterPath)
+	if posterPath == "" {
+		posterPath = show.PosterPath
+	}
+
+	return &party.Movie{
+		ID:            id.String(),
+		ContentType:   party.ContentTypeSeason,
+		Title:         fmt.Sprintf("%s: %s", show.Title, season.Name),
+		Year:          releaseYear(season.AirDate),
+		PosterPath:    posterPath,
+		Overview:      overview,
+		Rating:        season.VoteAverage,
+		Runtime:       runtime,
+		Genres:        show.Genres,
+		Certification: show.Certification,
+		Director:      show.Director,
+		Cast:          show.Cast,
+		TrailerKey:    show.TrailerKey,
+		ShowTitle:     show.Title,
+		SeasonNumber:  season.SeasonNumber,
+		EpisodeCount:  len(season.Episodes),
+	}, nil
+}
+
+// getEpisodeDetails fetches an episode and combines it with its series details
+func (c *Client) getEpisodeDetails(ctx context.Context, id contentID) (*party.Movie, error) {
+	show, err := c.GetMovieDetails(ctx, tvShowID(id.showID))
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("append_to_response", "credits")
+
+	var episode TMDBEpisodeDetails
+	if err := c.getJSON(ctx, id.path(), params, &episode); err != nil {
+		return nil, err
+	}
+
+	director := episode.Credits.director()
+	if director == "" {
+		director = show.Director
+	}
+
+	cast := episode.Credits.topCast(maxCastMembers)
+	if len(cast) == 0 {
+		cast = show.Cast
+	}
+
+	// Episodes have stills rather than posters
+	posterPath := posterURL(episode.StillPath)
+	if posterPath == "" {
+		posterPath = show.PosterPath
+	}
+
+	return &party.Movie{
+		ID:            id.String(),
+		ContentType:   party.ContentTypeEpisode,
+		Title:         fmt.Sprintf("%s S%02dE%02d: %s", show.Title, episode.SeasonNumber, episode.EpisodeNumber, episode.Name),
+		Year:          releaseYear(episode.AirDate),
+		PosterPath:    posterPath,
+		Overview:      episode.Overview,
+		Rating:        episode.VoteAverage,
+		Runtime:       episode.Runtime,
+		Genres:        show.Genres,
+		Certification: show.Certification,
+		Director:      director,
+		Cast:          cast,
+		ShowTitle:     show.Title,
+		SeasonNumber:  episode.SeasonNumber,
+		EpisodeNumber: episode.EpisodeNumber,
+	}, nil
+}
+
+// contentRating returns the age rating of the series for the given country
+func (d *TMDBTVDetails) contentRating(country string) string {
+	for _, result := range d.ContentRatings.Results {
+		if result.Country == country {
+			return result.Rating
+		}
+	}
+	return ""
+}
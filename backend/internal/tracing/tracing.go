@@ -0,0 +1,197 @@
+// Package tracing sets up OpenTelemetry tracing and creates spans
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Span exporters
+const (
+	ExporterNone   = "none"   // Tracing disabled
+	ExporterOTLP   = "otlp"   // OTLP over HTTP
+	ExporterStdout = "stdout" // Pretty-printed spans on stdout, for local debugging
+)
+
+// ServiceName identifies the backend in traces
+const ServiceName = "reelchoice-backend"
+
+// Span attribute keys
+const (
+	KeyPartyID     = attribute.Key("party.id")
+	KeyUserID      = attribute.Key("user.id")
+	KeyMessageType = attribute.Key("ws.message.type")
+)
+
+// The global tracer delegates to the provider installed by Setup, so spans
+// started before Setup or with tracing disabled are no-ops
+var tracer = otel.Tracer("github.com/reelchoice/backend")
+
+// Setup installs the global tracer provider and W3C trace context
+// propagation. endpoint is the OTLP collector URL; when empty, the OTLP
+// exporter uses OTEL_EXPORTER_OTLP_ENDPOINT or http://localhost:4318. The
+// returned function flushes pending spans and must be called on shutdown.
+func Setup(ctx context.Context, exporter, endpoint string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var spanExporter sdktrace.SpanExporter
+	var err error
+	switch exporter {
+	case ExporterNone, "":
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		var opts []otlptracehttp.Option
+		if endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(endpoint))
+		}
+		spanExporter, err = otlptracehttp.New(ctx, opts...)
+	case ExporterStdout:
+		spanExporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("invalid tracing exporter %q: must be %q, %q or %q", exporter, ExporterNone, ExporterOTLP, ExporterStdout)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s span exporter: %w", exporter, err)
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", ServiceName)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Start starts a span as a child of the span in ctx, if any
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartLinked starts a new trace linked to the span described by link, for
+// work such as WebSocket messages that is caused by but outlives a request
+func StartLinked(link trace.SpanContext, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	opts := []trace.SpanStartOption{trace.WithNewRoot(), trace.WithAttributes(attrs...)}
+	if link.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: link}))
+	}
+	return tracer.Start(context.Background(), name, opts...)
+}
+
+// StartClient starts a span for a call to an external service
+func StartClient(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// RecordError marks the span in ctx as failed with err. It does nothing when
+// err is nil.
+func RecordError(ctx context.Context, err error) {
+	if err == nil {
+		return
+	}
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// Middleware starts a server span for each HTTP request, continuing the
+// trace of the caller if the request carries trace context. Spans are named
+// after the matched chi route pattern to keep party IDs out of span names.
+// Outgoing API calls are traced by hand rather than with otelhttp, whose
+// client spans record the full URL including API keys.
+func Middleware(next http.Handler) http.Handler {
+	return otelhttp.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r)
+		if rctx := chi.RouteContext(r.Context()); rctx != nil {
+			if pattern := rctx.RoutePattern(); pattern != "" {
+				trace.SpanFromContext(r.Context()).SetName(r.Method + " " + pattern)
+			}
+		}
+	}), "http.request")
+}
+
+// RedisHook traces Redis commands issued within a trace. Commands without a
+// span in their context, such as the startup ping, are not traced. Add it to
+// a client with AddHook.
+type RedisHook struct{}
+
+// DialHook implements redis.Hook
+func (RedisHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+// ProcessHook implements redis.Hook
+func (RedisHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if !trace.SpanContextFromContext(ctx).IsValid() {
+			return next(ctx, cmd)
+		}
+
+		ctx, span := startRedisSpan(ctx, cmd.Name())
+		defer span.End()
+
+		err := next(ctx, cmd)
+		recordRedisError(ctx, err)
+		return err
+	}
+}
+
+// ProcessPipelineHook implements redis.Hook
+func (RedisHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if !trace.SpanContextFromContext(ctx).IsValid() {
+			return next(ctx, cmds)
+		}
+
+		ctx, span := startRedisSpan(ctx, "pipeline")
+		defer span.End()
+		span.SetAttributes(attribute.Int("db.operation.batch.size", len(cmds)))
+
+		err := next(ctx, cmds)
+		recordRedisError(ctx, err)
+		return err
+	}
+}
+
+// startRedisSpan starts a client span for a Redis operation. Keys and
+// arguments are left out since they can contain tokens.
+func startRedisSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return StartClient(ctx, "redis "+operation,
+		attribute.String("db.system.name", "redis"),
+		attribute.String("db.operation.name", operation),
+	)
+}
+
+// recordRedisError records err unless it only reports a missing key
+func recordRedisError(ctx context.Context, err error) {
+	if err != redis.Nil {
+		RecordError(ctx, err)
+	}
+}
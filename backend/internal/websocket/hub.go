@@ -3,29 +3,37 @@ package websocket
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"errors"
+	"log/slog"
+	"math/rand/v2"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/gorilla/websocket"
+	"github.com/reelchoice/backend/internal/apierror"
 	"github.com/reelchoice/backend/internal/database"
+	"github.com/reelchoice/backend/internal/health"
+	"github.com/reelchoice/backend/internal/logging"
+	"github.com/reelchoice/backend/internal/metrics"
 	"github.com/reelchoice/backend/internal/party"
-	"github.com/reelchoice/backend/internal/tmdb"
+	"github.com/reelchoice/backend/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Hub manages WebSocket connections for all parties
 type Hub struct {
 	// Active connections for each party (partyID -> connections)
-	parties map[string]map[*websocket.Conn]bool
+	parties map[string]map[*Connection]bool
 	mutex   sync.RWMutex
 
 	// Redis client for state management
 	redis *database.RedisClient
 
-	// TMDB client for movie data
-	tmdbClient *tmdb.Client
+	// Metadata provider for movie data
+	tmdbClient party.TMDBClient
 
 	// Token manager for authentication
 	tokenManager *party.TokenManager
@@ -33,10 +41,20 @@ type Hub struct {
 	// Party service for business logic
 	partyService *party.Service
 
+	// Pending deadline timers (partyID -> timer)
+	timers     map[string]*time.Timer
+	timerMutex sync.Mutex
+
 	// Channels for connection management
 	register   chan *Connection
 	unregister chan *Connection
 	broadcast  chan *BroadcastMessage
+
+	// Set once shutdown starts; new connections are refused
+	closing atomic.Bool
+
+	// Closed once Run has shut the hub down
+	done chan struct{}
 }
 
 // Connection represents a WebSocket connection with metadata
@@ -45,6 +63,32 @@ type Connection struct {
 	PartyID  string
 	UserID   string
 	Username string
+
+	// Logs with the request ID of the WebSocket upgrade and the party and
+	// user IDs
+	Logger *slog.Logger
+
+	// Number of messages received, identifying each message in the logs
+	messageSeq int
+
+	// Span of the WebSocket upgrade request, linked from each message's trace
+	upgradeSpan trace.SpanContext
+
+	// Serializes writes, since the socket allows only one writer at a time
+	writeMutex sync.Mutex
+}
+
+// writeWait bounds how long a single write to a client may take
+const writeWait = 10 * time.Second
+
+// write sends a message to the client. It is safe to call from any
+// goroutine.
+func (c *Connection) write(messageType int, data []byte) error {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+
+	c.Conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return c.Conn.WriteMessage(messageType, data)
 }
 
 // BroadcastMessage represents a message to broadcast to a party
@@ -65,16 +109,18 @@ var upgrader = websocket.Upgrader{
 // NewHub creates a new WebSocket hub
 func NewHub(redisClient *database.RedisClient) *Hub {
 	return &Hub{
-		parties:    make(map[string]map[*websocket.Conn]bool),
+		parties:    make(map[string]map[*Connection]bool),
 		redis:      redisClient,
 		register:   make(chan *Connection),
 		unregister: make(chan *Connection),
 		broadcast:  make(chan *BroadcastMessage),
+		timers:     make(map[string]*time.Timer),
+		done:       make(chan struct{}),
 	}
 }
 
 // SetTMDBClient sets the TMDB client for the hub
-func (h *Hub) SetTMDBClient(tmdbClient *tmdb.Client) {
+func (h *Hub) SetTMDBClient(tmdbClient party.TMDBClient) {
 	h.tmdbClient = tmdbClient
 }
 
@@ -88,8 +134,33 @@ func (h *Hub) SetPartyService(partyService *party.Service) {
 	h.partyService = partyService
 }
 
-// Run starts the hub and handles connection management
-func (h *Hub) Run() {
+// maxMessageSize is the largest message accepted from a client, with room
+// for complete settings and long ranking or approval ballots
+const maxMessageSize = 8 * 1024
+
+// Shutdown timing
+const (
+	// How long shutdown waits for in-flight party operations, kept below the
+	// server's shutdown timeout so clients are still told to reconnect
+	drainTimeout = 8 * time.Second
+
+	// Clients are asked to reconnect after a random delay in this range
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 5 * time.Second
+)
+
+// Run restores the deadline timers of stored parties and handles connection
+// management until ctx is canceled, then shuts the hub down: new connections
+// are refused, in-flight party operations are waited for, and every client is
+// sent server_restarting before its socket is closed with code 1012 (service
+// restart). Run returns once the hub has shut down.
+func (h *Hub) Run(ctx context.Context) {
+	defer close(h.done)
+
+	go h.restoreDeadlines(ctx)
+
+	stop := ctx.Done()
+	var drained <-chan struct{}
 	for {
 		select {
 		case conn := <-h.register:
@@ -100,22 +171,120 @@ func (h *Hub) Run() {
 
 		case message := <-h.broadcast:
 			h.broadcastToParty(message.PartyID, message.Data)
+
+		case <-stop:
+			// Keep delivering broadcasts from operations that are finishing
+			stop = nil
+			drained = h.beginShutdown()
+
+		case <-drained:
+			h.closeConnections()
+			return
 		}
 	}
 }
 
+// Done is closed once Run has shut the hub down
+func (h *Hub) Done() <-chan struct{} {
+	return h.done
+}
+
+// Ready is a readiness check that fails once shutdown has started, so load
+// balancers stop sending new connections while the hub closes existing ones
+func (h *Hub) Ready(ctx context.Context) error {
+	if h.closing.Load() {
+		return health.ErrShuttingDown
+	}
+	return nil
+}
+
+// beginShutdown refuses new connections, stops deadline timers and drains
+// the party service. The returned channel is closed once in-flight
+// operations have finished or drainTimeout has passed.
+func (h *Hub) beginShutdown() <-chan struct{} {
+	h.closing.Store(true)
+	slog.Info("WebSocket hub shutting down")
+
+	h.timerMutex.Lock()
+	for partyID, timer := range h.timers {
+		timer.Stop()
+		delete(h.timers, partyID)
+	}
+	h.timerMutex.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		defer close(drained)
+		if h.partyService == nil {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+		defer cancel()
+		if err := h.partyService.Drain(ctx); err != nil {
+			slog.Warn("Party operations still in flight at shutdown", "error", err)
+		}
+	}()
+	return drained
+}
+
+// closeConnections tells every client to reconnect and closes its socket
+func (h *Hub) closeConnections() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	closeMessage := websocket.FormatCloseMessage(websocket.CloseServiceRestart, "server restarting")
+	closed := 0
+	for partyID, connections := range h.parties {
+		for conn := range connections {
+			// Hold the write lock so the close frame follows any message
+			// being sent to the client
+			conn.writeMutex.Lock()
+			deadline := time.Now().Add(time.Second)
+			if data, err := restartingMessage(); err == nil {
+				conn.Conn.SetWriteDeadline(deadline)
+				conn.Conn.WriteMessage(websocket.TextMessage, data)
+			}
+			conn.Conn.WriteControl(websocket.CloseMessage, closeMessage, deadline)
+			conn.writeMutex.Unlock()
+			conn.Conn.Close()
+			closed++
+		}
+		delete(h.parties, partyID)
+	}
+
+	metrics.WSConnections.Sub(float64(closed))
+	metrics.ActiveParties.Set(0)
+	slog.Info("WebSocket hub shut down", "closed_connections", closed)
+}
+
+// restartingMessage creates a server_restarting message with a random
+// reconnect delay
+func restartingMessage() ([]byte, error) {
+	delay := minReconnectDelay + rand.N(maxReconnectDelay-minReconnectDelay)
+	msg, err := party.CreateMessage(party.MessageTypeServerRestarting, party.ServerRestartingPayload{
+		ReconnectAfterMs: int(delay.Milliseconds()),
+	})
+	if err != nil {
+		slog.Error("Error creating server restarting message", "error", err)
+		return nil, err
+	}
+	return json.Marshal(msg)
+}
+
 // registerConnection adds a new connection to the hub
 func (h *Hub) registerConnection(conn *Connection) {
 	h.mutex.Lock()
 	defer h.mutex.Unlock()
 
 	if h.parties[conn.PartyID] == nil {
-		h.parties[conn.PartyID] = make(map[*websocket.Conn]bool)
+		h.parties[conn.PartyID] = make(map[*Connection]bool)
 	}
 
-	h.parties[conn.PartyID][conn.Conn] = true
-	log.Printf("User %s connected to party %s. Total connections: %d",
-		conn.Username, conn.PartyID, len(h.parties[conn.PartyID]))
+	h.parties[conn.PartyID][conn] = true
+	metrics.WSConnections.Inc()
+	metrics.ActiveParties.Set(float64(len(h.parties)))
+	conn.Logger.Info("User connected", "party_connections", len(h.parties[conn.PartyID]))
 }
 
 // unregisterConnection removes a connection from the hub
@@ -124,17 +293,18 @@ func (h *Hub) unregisterConnection(conn *Connection) {
 	defer h.mutex.Unlock()
 
 	if connections, exists := h.parties[conn.PartyID]; exists {
-		if connections[conn.Conn] {
-			delete(connections, conn.Conn)
+		if connections[conn] {
+			delete(connections, conn)
 			conn.Conn.Close()
+			metrics.WSConnections.Dec()
 
 			// Remove party if no connections left
 			if len(connections) == 0 {
 				delete(h.parties, conn.PartyID)
 			}
+			metrics.ActiveParties.Set(float64(len(h.parties)))
 
-			log.Printf("User %s disconnected from party %s. Remaining connections: %d",
-				conn.Username, conn.PartyID, len(connections))
+			conn.Logger.Info("User disconnected", "party_connections", len(connections))
 		}
 	}
 }
@@ -144,23 +314,22 @@ func (h *Hub) Broadcast(partyID string, message []byte) {
 	select {
 	case h.broadcast <- &BroadcastMessage{PartyID: partyID, Data: message}:
 	default:
-		log.Printf("Broadcast channel full, dropping message for party %s", partyID)
+		slog.Warn("Broadcast channel full, dropping message", logging.KeyPartyID, partyID)
 	}
 }
 
 // broadcastToParty sends data to all connections in a specific party
 func (h *Hub) broadcastToParty(partyID string, data []byte) {
 	h.mutex.RLock()
-	connections := h.parties[partyID]
-	h.mutex.RUnlock()
-
-	if connections == nil {
-		return
+	connections := make([]*Connection, 0, len(h.parties[partyID]))
+	for conn := range h.parties[partyID] {
+		connections = append(connections, conn)
 	}
+	h.mutex.RUnlock()
 
-	for conn := range connections {
-		if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
-			log.Printf("Error writing to websocket: %v", err)
+	for _, conn := range connections {
+		if err := conn.write(websocket.TextMessage, data); err != nil {
+			slog.Warn("Error writing to websocket", logging.KeyPartyID, partyID, "error", err)
 			// Connection will be cleaned up by the read pump
 		}
 	}
@@ -168,66 +337,72 @@ func (h *Hub) broadcastToParty(partyID string, data []byte) {
 
 // ServeWS handles WebSocket connections
 func (h *Hub) ServeWS(w http.ResponseWriter, r *http.Request) {
+	if h.closing.Load() {
+		apierror.Write(w, party.ErrShuttingDown)
+		return
+	}
+
 	// Extract party ID from URL
 	partyID := chi.URLParam(r, "partyID")
 	if partyID == "" {
-		http.Error(w, "Party ID is required", http.StatusBadRequest)
+		apierror.Write(w, party.ErrInvalidRequest.WithMessage("Party ID is required"))
 		return
 	}
 
 	// Extract and validate auth token
 	token := r.URL.Query().Get("token")
 	if token == "" {
-		http.Error(w, "Authentication token is required", http.StatusUnauthorized)
+		apierror.Write(w, party.ErrUnauthorized.WithMessage("Authentication token is required"))
 		return
 	}
 
 	if h.tokenManager == nil {
-		http.Error(w, "Authentication not configured", http.StatusInternalServerError)
+		apierror.Write(w, party.ErrInternal.WithMessage("Authentication not configured"))
 		return
 	}
 
-	ctx := context.Background()
+	ctx := logging.With(r.Context(), logging.KeyPartyID, partyID)
 	tokenInfo, err := h.tokenManager.ValidateToken(ctx, token)
 	if err != nil {
-		http.Error(w, "Invalid or expired token", http.StatusUnauthorized)
+		apierror.Write(w, party.ErrUnauthorized.WithMessage("Invalid or expired token"))
 		return
 	}
 
 	// Verify token is for this party
 	if tokenInfo.PartyID != partyID {
-		http.Error(w, "Token not valid for this party", http.StatusForbidden)
+		apierror.Write(w, party.ErrForbidden.WithMessage("Token not valid for this party"))
 		return
 	}
 
+	// User info comes from validated token
+	userID := tokenInfo.UserID
+	username := tokenInfo.Username
+	ctx = logging.With(ctx, logging.KeyUserID, userID)
+
 	// Upgrade HTTP connection to WebSocket
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
-		log.Printf("WebSocket upgrade error: %v", err)
+		logging.FromContext(ctx).Warn("WebSocket upgrade error", "error", err)
 		return
 	}
 
 	// Get or create party
 	partyData, err := h.redis.GetParty(ctx, partyID)
 	if err != nil {
-		log.Printf("Error getting party %s: %v", partyID, err)
+		logging.FromContext(ctx).Error("Error getting party", "error", err)
 		conn.Close()
 		return
 	}
 
 	if partyData == nil {
-		log.Printf("Party %s not found", partyID)
+		logging.FromContext(ctx).Warn("Party not found")
 		conn.Close()
 		return
 	}
 
-	// User info comes from validated token
-	userID := tokenInfo.UserID
-	username := tokenInfo.Username
-
 	// Verify user is still in the party (they might have been removed)
 	if participant := partyData.GetParticipant(userID); participant == nil {
-		log.Printf("User %s (ID: %s) no longer in party %s", username, userID, partyID)
+		logging.FromContext(ctx).Warn("User no longer in party")
 		conn.Close()
 		return
 	}
@@ -237,10 +412,24 @@ func (h *Hub) ServeWS(w http.ResponseWriter, r *http.Request) {
 		PartyID:  partyID,
 		UserID:   userID,
 		Username: username,
+		Logger:   logging.FromContext(ctx),
+
+		upgradeSpan: trace.SpanContextFromContext(ctx),
+	}
+
+	// Register the connection, unless the hub has already shut down
+	select {
+	case h.register <- connection:
+	case <-h.done:
+		closeMessage := websocket.FormatCloseMessage(websocket.CloseServiceRestart, "server restarting")
+		conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(time.Second))
+		conn.Close()
+		return
 	}
 
-	// Register the connection
-	h.register <- connection
+	// Pick up the party's deadline in case it was set before a restart or
+	// on another instance
+	h.scheduleDeadline(partyData)
 
 	// Start goroutines for this connection
 	go h.writePump(connection)
@@ -258,8 +447,7 @@ func (h *Hub) writePump(conn *Connection) {
 	for {
 		select {
 		case <-ticker.C:
-			conn.Conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
-			if err := conn.Conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+			if err := conn.write(websocket.PingMessage, nil); err != nil {
 				return
 			}
 		}
@@ -269,12 +457,16 @@ func (h *Hub) writePump(conn *Connection) {
 // readPump handles receiving messages from the WebSocket connection
 func (h *Hub) readPump(conn *Connection) {
 	defer func() {
-		h.unregister <- conn
+		select {
+		case h.unregister <- conn:
+		case <-h.done:
+			// closeConnections already closed the socket
+		}
 		// Note: We no longer remove users from party state on disconnect
 		// Users remain in the party until they explicitly leave or the party expires
 	}()
 
-	conn.Conn.SetReadLimit(512)
+	conn.Conn.SetReadLimit(maxMessageSize)
 	conn.Conn.SetReadDeadline(time.Now().Add(60 * time.Second))
 	conn.Conn.SetPongHandler(func(string) error {
 		conn.Conn.SetReadDeadline(time.Now().Add(60 * time.Second))
@@ -285,7 +477,7 @@ func (h *Hub) readPump(conn *Connection) {
 		_, message, err := conn.Conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-				log.Printf("WebSocket error: %v", err)
+				conn.Logger.Warn("WebSocket error", "error", err)
 			}
 			break
 		}
@@ -297,14 +489,31 @@ func (h *Hub) readPump(conn *Connection) {
 
 // handleMessage processes incoming WebSocket messages
 func (h *Hub) handleMessage(conn *Connection, message []byte) {
+	conn.messageSeq++
+	logger := conn.Logger.With("message_seq", conn.messageSeq)
+
 	var msg party.Message
 	if err := json.Unmarshal(message, &msg); err != nil {
-		log.Printf("Error parsing message: %v", err)
-		h.sendError(conn, "Invalid message format")
+		logger.Warn("Error parsing message", "error", err)
+		metrics.WSMessages.WithLabelValues("invalid").Inc()
+		h.sendError(conn, party.ErrInvalidRequest.WithMessage("Invalid message format"))
 		return
 	}
 
-	ctx := context.Background()
+	// Each message starts its own trace, linked to the upgrade request, and is
+	// handled with a logger carrying the connection's request, party and user
+	// IDs
+	ctx, span := tracing.StartLinked(conn.upgradeSpan, "ws.message",
+		tracing.KeyPartyID.String(conn.PartyID),
+		tracing.KeyUserID.String(conn.UserID),
+	)
+	defer span.End()
+	logger = logger.With("message_type", msg.Type)
+	if spanContext := span.SpanContext(); spanContext.HasTraceID() {
+		logger = logger.With(logging.KeyTraceID, spanContext.TraceID().String())
+	}
+	ctx = logging.WithLogger(ctx, logger)
+	messageType := msg.Type
 
 	switch msg.Type {
 	case party.MessageTypePing:
@@ -313,22 +522,63 @@ func (h *Hub) handleMessage(conn *Connection, message []byte) {
 	case party.MessageTypeSearchMovies:
 		h.handleSearchMovies(ctx, conn, &msg)
 
+	case party.MessageTypeUpdateSettings:
+		h.handleUpdateSettings(ctx, conn, &msg)
+
+	case party.MessageTypeSetVoterWeight:
+		h.handleSetVoterWeight(ctx, conn, &msg)
+
+	case party.MessageTypeStartNomination:
+		h.handleStartNomination(ctx, conn, &msg)
+
 	case party.MessageTypeSuggestMovie:
 		h.handleSuggestMovie(ctx, conn, &msg)
 
 	case party.MessageTypeVoteNomination:
 		h.handleVoteNomination(ctx, conn, &msg)
 
+	case party.MessageTypeRetractVote:
+		h.handleRetractVote(ctx, conn, &msg)
+
+	case party.MessageTypeCloseVoting:
+		h.handleCloseVoting(ctx, conn, &msg)
+
+	case party.MessageTypeVetoMovie:
+		h.handleVetoMovie(ctx, conn, &msg)
+
 	case party.MessageTypeFinalizeNominations:
 		h.handleFinalizeNominations(ctx, conn, &msg)
 
+	case party.MessageTypeSubmitApprovals:
+		h.handleSubmitApprovals(ctx, conn, &msg)
+
+	case party.MessageTypeCloseApproval:
+		h.handleCloseApproval(ctx, conn, &msg)
+
 	case party.MessageTypeSubmitRanking:
 		h.handleSubmitRanking(ctx, conn, &msg)
 
+	case party.MessageTypeCloseRanking:
+		h.handleCloseRanking(ctx, conn, &msg)
+
+	case party.MessageTypeVoteRunoff:
+		h.handleVoteRunoff(ctx, conn, &msg)
+
+	case party.MessageTypeDecideRunoff:
+		h.handleDecideRunoff(ctx, conn, &msg)
+
+	case party.MessageTypeRematch:
+		h.handleRematch(ctx, conn, &msg)
+
 	default:
-		log.Printf("Unknown message type: %s", msg.Type)
-		h.sendError(conn, "Unknown message type")
+		logging.FromContext(ctx).Warn("Unknown message type")
+		messageType = "unknown" // Keep arbitrary client input out of metric labels
+		h.sendError(conn, party.ErrUnknownMessageType.WithDetail("type", msg.Type))
 	}
+
+	metrics.WSMessages.WithLabelValues(messageType).Inc()
+	span.SetName("ws " + messageType)
+	span.SetAttributes(tracing.KeyMessageType.String(messageType))
 }
 
 // handlePing responds to ping messages
@@ -338,171 +588,587 @@ func (h *Hub) handlePing(conn *Connection) {
 		"timestamp": time.Now().Unix(),
 	}
 	responseData, _ := json.Marshal(response)
-	conn.Conn.WriteMessage(websocket.TextMessage, responseData)
+	conn.write(websocket.TextMessage, responseData)
 }
 
 // handleSearchMovies handles movie search requests
 func (h *Hub) handleSearchMovies(ctx context.Context, conn *Connection, msg *party.Message) {
 	if h.partyService == nil {
-		h.sendError(conn, "Party service not available")
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
 		return
 	}
 
 	var payload party.SearchMoviesPayload
 	if err := msg.ParsePayload(&payload); err != nil {
-		h.sendError(conn, "Invalid search payload")
+		h.sendError(conn, party.ErrInvalidRequest.WithMessage("Invalid search payload"))
 		return
 	}
 
-	movies, err := h.partyService.SearchMovies(ctx, payload.Query)
+	if err := payload.SearchOptions.Validate(); err != nil {
+		h.sendError(conn, err)
+		return
+	}
+
+	result, err := h.partyService.SearchMovies(ctx, payload.Query, payload.SearchOptions)
 	if err != nil {
-		log.Printf("Error searching movies: %v", err)
-		h.sendError(conn, "Movie search failed")
+		logging.FromContext(ctx).Warn("Error searching movies", "error", err)
+		h.sendError(conn, err)
 		return
 	}
 
 	// Send search results back to the requesting client
 	response := party.SearchResultsPayload{
-		Query:  payload.Query,
-		Movies: movies,
+		Query:        payload.Query,
+		Movies:       result.Movies,
+		Page:         result.Page,
+		TotalResults: result.TotalResults,
+		TotalPages:   result.TotalPages,
 	}
 
 	responseMsg, err := party.CreateMessage(party.MessageTypeSearchResults, response)
 	if err != nil {
-		h.sendError(conn, "Failed to create response")
+		h.sendError(conn, party.ErrInternal.WithMessage("Failed to create response"))
 		return
 	}
 
 	responseData, _ := json.Marshal(responseMsg)
-	conn.Conn.WriteMessage(websocket.TextMessage, responseData)
+	conn.write(websocket.TextMessage, responseData)
+}
+
+// handleUpdateSettings handles changes to the party settings (host only)
+func (h *Hub) handleUpdateSettings(ctx context.Context, conn *Connection, msg *party.Message) {
+	if h.partyService == nil {
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
+		return
+	}
+
+	var payload party.UpdateSettingsPayload
+	if err := msg.ParsePayload(&payload); err != nil {
+		h.sendError(conn, party.ErrInvalidRequest.WithMessage("Invalid settings payload"))
+		return
+	}
+
+	// Use the party service to update the settings
+	updatedParty, err := h.partyService.UpdateSettings(ctx, conn.PartyID, conn.UserID, payload)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error updating settings", "error", err)
+		h.sendError(conn, err)
+		return
+	}
+
+	// Broadcast updated party state
+	h.BroadcastParty(updatedParty)
+}
+
+// handleSetVoterWeight handles setting a participant's voter weight (host only)
+func (h *Hub) handleSetVoterWeight(ctx context.Context, conn *Connection, msg *party.Message) {
+	if h.partyService == nil {
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
+		return
+	}
+
+	var payload party.SetVoterWeightPayload
+	if err := msg.ParsePayload(&payload); err != nil {
+		h.sendError(conn, party.ErrInvalidRequest.WithMessage("Invalid voter weight payload"))
+		return
+	}
+
+	// Use the party service to set the voter weight
+	updatedParty, err := h.partyService.SetVoterWeight(ctx, conn.PartyID, conn.UserID, payload.UserID, payload.Weight)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error setting voter weight", "error", err)
+		h.sendError(conn, err)
+		return
+	}
+
+	// Broadcast updated party state
+	h.BroadcastParty(updatedParty)
+}
+
+// handleStartNomination handles starting the nomination phase (host only)
+func (h *Hub) handleStartNomination(ctx context.Context, conn *Connection, msg *party.Message) {
+	if h.partyService == nil {
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
+		return
+	}
+
+	// Use the party service to start nominations
+	updatedParty, err := h.partyService.StartNomination(ctx, conn.PartyID, conn.UserID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error starting nomination", "error", err)
+		h.sendError(conn, err)
+		return
+	}
+
+	// Broadcast updated party state
+	h.BroadcastParty(updatedParty)
 }
 
 // handleSuggestMovie handles movie suggestion messages
 func (h *Hub) handleSuggestMovie(ctx context.Context, conn *Connection, msg *party.Message) {
 	if h.partyService == nil {
-		h.sendError(conn, "Party service not available")
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
 		return
 	}
 
 	var payload party.SuggestMoviePayload
 	if err := msg.ParsePayload(&payload); err != nil {
-		h.sendError(conn, "Invalid suggestion payload")
+		h.sendError(conn, party.ErrInvalidRequest.WithMessage("Invalid suggestion payload"))
 		return
 	}
 
 	// Use the party service to handle the suggestion
 	updatedParty, err := h.partyService.SuggestMovie(ctx, conn.PartyID, conn.UserID, payload.TMDBID)
 	if err != nil {
-		log.Printf("Error suggesting movie: %v", err)
-		h.sendError(conn, err.Error())
+		logging.FromContext(ctx).Warn("Error suggesting movie", "error", err)
+		h.sendError(conn, err)
 		return
 	}
 
 	// Broadcast updated party state
-	h.broadcastPartyState(updatedParty)
+	h.BroadcastParty(updatedParty)
 }
 
 // handleVoteNomination handles nomination voting
 func (h *Hub) handleVoteNomination(ctx context.Context, conn *Connection, msg *party.Message) {
 	if h.partyService == nil {
-		h.sendError(conn, "Party service not available")
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
 		return
 	}
 
 	var payload party.VotePayload
 	if err := msg.ParsePayload(&payload); err != nil {
-		h.sendError(conn, "Invalid vote payload")
+		h.sendError(conn, party.ErrInvalidRequest.WithMessage("Invalid vote payload"))
 		return
 	}
 
 	// Use the party service to handle the vote
 	updatedParty, err := h.partyService.VoteNomination(ctx, conn.PartyID, conn.UserID, payload.Vote)
 	if err != nil {
-		log.Printf("Error voting on nomination: %v", err)
-		h.sendError(conn, err.Error())
+		logging.FromContext(ctx).Warn("Error voting on nomination", "error", err)
+		h.sendError(conn, err)
 		return
 	}
 
 	// Broadcast updated party state
-	h.broadcastPartyState(updatedParty)
+	h.BroadcastParty(updatedParty)
+}
+
+// handleRetractVote handles withdrawal of a nomination vote
+func (h *Hub) handleRetractVote(ctx context.Context, conn *Connection, msg *party.Message) {
+	if h.partyService == nil {
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
+		return
+	}
+
+	// Use the party service to retract the vote
+	updatedParty, err := h.partyService.RetractVote(ctx, conn.PartyID, conn.UserID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error retracting vote", "error", err)
+		h.sendError(conn, err)
+		return
+	}
+
+	// Broadcast updated party state
+	h.BroadcastParty(updatedParty)
+}
+
+// handleCloseVoting handles closing the vote on the current nomination (host only)
+func (h *Hub) handleCloseVoting(ctx context.Context, conn *Connection, msg *party.Message) {
+	if h.partyService == nil {
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
+		return
+	}
+
+	// Use the party service to close voting
+	updatedParty, err := h.partyService.CloseVoting(ctx, conn.PartyID, conn.UserID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error closing voting", "error", err)
+		h.sendError(conn, err)
+		return
+	}
+
+	// Broadcast updated party state
+	h.BroadcastParty(updatedParty)
+}
+
+// handleVetoMovie handles a participant vetoing a movie
+func (h *Hub) handleVetoMovie(ctx context.Context, conn *Connection, msg *party.Message) {
+	if h.partyService == nil {
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
+		return
+	}
+
+	var payload party.VetoPayload
+	if err := msg.ParsePayload(&payload); err != nil {
+		h.sendError(conn, party.ErrInvalidRequest.WithMessage("Invalid veto payload"))
+		return
+	}
+
+	// Use the party service to apply the veto
+	updatedParty, veto, err := h.partyService.VetoMovie(ctx, conn.PartyID, conn.UserID, payload.MovieID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error vetoing movie", "error", err)
+		h.sendError(conn, err)
+		return
+	}
+
+	// Announce the veto, then broadcast updated party state
+	h.BroadcastVeto(updatedParty, *veto)
+	h.BroadcastParty(updatedParty)
 }
 
 // handleFinalizeNominations handles finalization of nominations (host only)
 func (h *Hub) handleFinalizeNominations(ctx context.Context, conn *Connection, msg *party.Message) {
 	if h.partyService == nil {
-		h.sendError(conn, "Party service not available")
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
 		return
 	}
 
 	// Use the party service to finalize nominations
 	updatedParty, err := h.partyService.FinalizeNominations(ctx, conn.PartyID, conn.UserID)
 	if err != nil {
-		log.Printf("Error finalizing nominations: %v", err)
-		h.sendError(conn, err.Error())
+		logging.FromContext(ctx).Warn("Error finalizing nominations", "error", err)
+		h.sendError(conn, err)
 		return
 	}
 
 	// Broadcast updated party state
-	h.broadcastPartyState(updatedParty)
+	h.BroadcastParty(updatedParty)
+}
+
+// handleSubmitApprovals handles approval round submissions
+func (h *Hub) handleSubmitApprovals(ctx context.Context, conn *Connection, msg *party.Message) {
+	if h.partyService == nil {
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
+		return
+	}
+
+	var payload party.SubmitApprovalsPayload
+	if err := msg.ParsePayload(&payload); err != nil {
+		h.sendError(conn, party.ErrInvalidRequest.WithMessage("Invalid approvals payload"))
+		return
+	}
+
+	// Use the party service to record the approvals
+	updatedParty, err := h.partyService.SubmitApprovals(ctx, conn.PartyID, conn.UserID, payload.MovieIDs)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error submitting approvals", "error", err)
+		h.sendError(conn, err)
+		return
+	}
+
+	// Broadcast updated party state
+	h.BroadcastParty(updatedParty)
+}
+
+// handleCloseApproval handles ending the approval round (host only)
+func (h *Hub) handleCloseApproval(ctx context.Context, conn *Connection, msg *party.Message) {
+	if h.partyService == nil {
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
+		return
+	}
+
+	// Use the party service to close the approval round
+	updatedParty, err := h.partyService.CloseApproval(ctx, conn.PartyID, conn.UserID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error closing approval round", "error", err)
+		h.sendError(conn, err)
+		return
+	}
+
+	// Broadcast updated party state
+	h.BroadcastParty(updatedParty)
 }
 
 // handleSubmitRanking handles ranking submission messages
 func (h *Hub) handleSubmitRanking(ctx context.Context, conn *Connection, msg *party.Message) {
 	if h.partyService == nil {
-		h.sendError(conn, "Party service not available")
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
 		return
 	}
 
 	var payload party.SubmitRankingPayload
 	if err := msg.ParsePayload(&payload); err != nil {
-		h.sendError(conn, "Invalid ranking payload")
+		h.sendError(conn, party.ErrInvalidRequest.WithMessage("Invalid ranking payload"))
 		return
 	}
 
 	// Use the party service to handle the ranking submission
-	updatedParty, err := h.partyService.SubmitRanking(ctx, conn.PartyID, conn.UserID, payload.Ranks)
+	updatedParty, err := h.partyService.SubmitRanking(ctx, conn.PartyID, conn.UserID, payload.Ranks, payload.NoOpinion)
 	if err != nil {
-		log.Printf("Error submitting ranking: %v", err)
-		h.sendError(conn, err.Error())
+		logging.FromContext(ctx).Warn("Error submitting ranking", "error", err)
+		h.sendError(conn, err)
 		return
 	}
 
 	// Log if party is completed
 	if updatedParty.Phase == party.PhaseFinished && updatedParty.Winner != nil {
-		log.Printf("Party %s completed: Winner is %s", updatedParty.ID, updatedParty.Winner.Title)
+		logging.FromContext(ctx).Info("Party completed", "winner_id", updatedParty.Winner.ID)
+	}
+
+	// Broadcast updated party state
+	h.BroadcastParty(updatedParty)
+}
+
+// handleCloseRanking handles ending the ranking phase early (host only)
+func (h *Hub) handleCloseRanking(ctx context.Context, conn *Connection, msg *party.Message) {
+	if h.partyService == nil {
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
+		return
+	}
+
+	// Use the party service to close ranking
+	updatedParty, err := h.partyService.CloseRanking(ctx, conn.PartyID, conn.UserID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error closing ranking", "error", err)
+		h.sendError(conn, err)
+		return
+	}
+
+	// Log if party is completed
+	if updatedParty.Winner != nil {
+		logging.FromContext(ctx).Info("Party completed", "winner_id", updatedParty.Winner.ID)
+	}
+
+	// Broadcast updated party state
+	h.BroadcastParty(updatedParty)
+}
+
+// handleVoteRunoff handles votes in a runoff between tied movies
+func (h *Hub) handleVoteRunoff(ctx context.Context, conn *Connection, msg *party.Message) {
+	if h.partyService == nil {
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
+		return
+	}
+
+	var payload party.RunoffVotePayload
+	if err := msg.ParsePayload(&payload); err != nil {
+		h.sendError(conn, party.ErrInvalidRequest.WithMessage("Invalid runoff payload"))
+		return
+	}
+
+	// Use the party service to record the runoff vote
+	updatedParty, err := h.partyService.VoteRunoff(ctx, conn.PartyID, conn.UserID, payload.MovieID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error voting in runoff", "error", err)
+		h.sendError(conn, err)
+		return
+	}
+
+	// Broadcast updated party state
+	h.BroadcastParty(updatedParty)
+}
+
+// handleDecideRunoff handles the host picking the winner of a runoff (host only)
+func (h *Hub) handleDecideRunoff(ctx context.Context, conn *Connection, msg *party.Message) {
+	if h.partyService == nil {
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
+		return
+	}
+
+	var payload party.RunoffVotePayload
+	if err := msg.ParsePayload(&payload); err != nil {
+		h.sendError(conn, party.ErrInvalidRequest.WithMessage("Invalid runoff payload"))
+		return
+	}
+
+	// Use the party service to decide the runoff
+	updatedParty, err := h.partyService.DecideRunoff(ctx, conn.PartyID, conn.UserID, payload.MovieID)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error deciding runoff", "error", err)
+		h.sendError(conn, err)
+		return
 	}
 
 	// Broadcast updated party state
-	h.broadcastPartyState(updatedParty)
+	h.BroadcastParty(updatedParty)
 }
 
-// sendError sends an error message to a specific connection
-func (h *Hub) sendError(conn *Connection, errorMsg string) {
-	errorPayload := party.ErrorPayload{Error: errorMsg}
-	response, err := party.CreateMessage("error", errorPayload)
+// handleRematch handles starting a new round from a finished party (host only)
+func (h *Hub) handleRematch(ctx context.Context, conn *Connection, msg *party.Message) {
+	if h.partyService == nil {
+		h.sendError(conn, party.ErrInternal.WithMessage("Party service not available"))
+		return
+	}
+
+	var payload party.RematchPayload
+	if err := msg.ParsePayload(&payload); err != nil {
+		h.sendError(conn, party.ErrInvalidRequest.WithMessage("Invalid rematch payload"))
+		return
+	}
+
+	// Use the party service to start the rematch
+	updatedParty, err := h.partyService.Rematch(ctx, conn.PartyID, conn.UserID, payload.Phase, payload.CarryOverPool)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Error starting rematch", "error", err)
+		h.sendError(conn, err)
+		return
+	}
+
+	// Broadcast updated party state
+	h.BroadcastParty(updatedParty)
+}
+
+// sendError sends an error message with a stable error code to a specific connection
+func (h *Hub) sendError(conn *Connection, sendErr error) {
+	errorPayload := apierror.Payload(sendErr)
+	metrics.WSErrors.WithLabelValues(errorPayload.Code).Inc()
+	response, err := party.CreateMessage(party.MessageTypeError, errorPayload)
 	if err != nil {
-		log.Printf("Error creating error message: %v", err)
+		slog.Error("Error creating error message", "error", err)
 		return
 	}
 
 	responseData, _ := json.Marshal(response)
-	conn.Conn.WriteMessage(websocket.TextMessage, responseData)
+	conn.write(websocket.TextMessage, responseData)
 }
 
-// broadcastPartyState sends the current party state to all connections
-func (h *Hub) broadcastPartyState(partyData *party.Party) {
-	payload := party.PartyUpdatePayload{Party: partyData}
+// BroadcastParty sends the party state, as participants may see it, to all
+// connections and schedules resolution of its next deadline
+func (h *Hub) BroadcastParty(partyData *party.Party) {
+	h.scheduleDeadline(partyData)
+	h.sendPartyState(partyData)
+}
+
+// sendPartyState sends the party state, as participants may see it, to all
+// connections
+func (h *Hub) sendPartyState(partyData *party.Party) {
+	payload := party.PartyUpdatePayload{Party: partyData.View()}
 	msg, err := party.CreateMessage(party.MessageTypePartyUpdate, payload)
 	if err != nil {
-		log.Printf("Error creating party update message: %v", err)
+		slog.Error("Error creating party update message", "error", err)
 		return
 	}
 
 	data, err := json.Marshal(msg)
 	if err != nil {
-		log.Printf("Error marshaling party state: %v", err)
+		slog.Error("Error marshaling party state", "error", err)
 		return
 	}
 
 	h.Broadcast(partyData.ID, data)
 }
+
+// BroadcastVeto announces a veto to all connections of the party, without
+// who used it if the party's vetoes are anonymous
+func (h *Hub) BroadcastVeto(partyData *party.Party, veto party.Veto) {
+	if partyData.Settings.AnonymousVetoes {
+		veto = veto.Anonymous()
+	}
+
+	msg, err := party.CreateMessage(party.MessageTypeMovieVetoed, party.MovieVetoedPayload{Veto: veto})
+	if err != nil {
+		slog.Error("Error creating veto message", "error", err)
+		return
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		slog.Error("Error marshaling veto message", "error", err)
+		return
+	}
+
+	h.Broadcast(partyData.ID, data)
+}
+
+// scheduleDeadline replaces the party's deadline timer with one for its
+// next deadline, if any
+func (h *Hub) scheduleDeadline(partyData *party.Party) {
+	h.timerMutex.Lock()
+	defer h.timerMutex.Unlock()
+
+	if timer, exists := h.timers[partyData.ID]; exists {
+		timer.Stop()
+		delete(h.timers, partyData.ID)
+	}
+
+	deadline, ok := partyData.NextDeadline()
+	if !ok || h.closing.Load() {
+		return
+	}
+
+	partyID := partyData.ID
+	h.timers[partyID] = time.AfterFunc(time.Until(deadline), func() {
+		h.resolveDeadline(partyID)
+	})
+}
+
+// resolveDeadline applies a passed deadline and broadcasts the result
+func (h *Hub) resolveDeadline(partyID string) {
+	if h.partyService == nil {
+		return
+	}
+
+	ctx := logging.With(context.Background(), logging.KeyPartyID, partyID)
+	updatedParty, err := h.partyService.ResolveDeadlines(ctx, partyID)
+	if errors.Is(err, party.ErrPartyBusy) {
+		// Another request holds the lock; try again shortly
+		if h.closing.Load() {
+			return
+		}
+		h.timerMutex.Lock()
+		if timer, exists := h.timers[partyID]; exists {
+			timer.Stop()
+		}
+		h.timers[partyID] = time.AfterFunc(time.Second, func() {
+			h.resolveDeadline(partyID)
+		})
+		h.timerMutex.Unlock()
+		return
+	}
+	if err != nil {
+		logging.FromContext(ctx).Error("Error resolving deadline", "error", err)
+		return
+	}
+
+	if updatedParty == nil {
+		// Another instance may have applied the deadline; bring this
+		// instance's connections up to date
+		h.refreshParty(ctx, partyID)
+		return
+	}
+	h.BroadcastParty(updatedParty)
+}
+
+// refreshParty sends the stored party state to the party's connections on
+// this instance, if it has any
+func (h *Hub) refreshParty(ctx context.Context, partyID string) {
+	h.mutex.RLock()
+	connected := len(h.parties[partyID]) > 0
+	h.mutex.RUnlock()
+	if !connected {
+		return
+	}
+
+	partyData, err := h.redis.GetParty(ctx, partyID)
+	if err != nil {
+		logging.FromContext(ctx).Error("Error getting party", "error", err)
+		return
+	}
+	if partyData != nil {
+		h.sendPartyState(partyData)
+	}
+}
+
+// restoreDeadlines schedules the deadlines of all parties stored in Redis,
+// so deadlines set before a restart or on another instance still resolve
+func (h *Hub) restoreDeadlines(ctx context.Context) {
+	partyIDs, err := h.redis.PartiesWithDeadlines(ctx)
+	if err != nil {
+		slog.Error("Error listing party deadlines", "error", err)
+		return
+	}
+
+	for _, partyID := range partyIDs {
+		partyData, err := h.redis.GetParty(ctx, partyID)
+		if err != nil {
+			slog.Error("Error getting party", logging.KeyPartyID, partyID, "error", err)
+			continue
+		}
+		if partyData != nil {
+			h.scheduleDeadline(partyData)
+		}
+	}
+	slog.Info("Restored party deadlines", "parties", len(partyIDs))
+}
@@ -0,0 +1,100 @@
+package websocket
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/reelchoice/backend/internal/health"
+)
+
+// newTestConnection returns a server-side connection registered with the
+// hub and the client end of its socket
+func newTestConnection(t *testing.T, h *Hub, partyID string) (*Connection, *websocket.Conn) {
+	t.Helper()
+
+	accepted := make(chan *websocket.Conn, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("upgrade: %v", err)
+			return
+		}
+		accepted <- conn
+	}))
+	t.Cleanup(server.Close)
+
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	conn := &Connection{Conn: <-accepted, PartyID: partyID, UserID: "user", Logger: slog.Default()}
+	h.registerConnection(conn)
+	return conn, client
+}
+
+func TestCloseConnectionsWhileBroadcasting(t *testing.T) {
+	h := NewHub(nil)
+	conn, client := newTestConnection(t, h, "party-1")
+
+	// Drain the client so writes do not block on a full buffer
+	received := make(chan []string, 1)
+	go func() {
+		var messages []string
+		for {
+			_, data, err := client.ReadMessage()
+			if err != nil {
+				received <- messages
+				return
+			}
+			messages = append(messages, string(data))
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			h.broadcastToParty("party-1", []byte(`{"type":"party_update"}`))
+		}()
+		go func() {
+			defer wg.Done()
+			conn.write(websocket.PingMessage, nil)
+		}()
+	}
+	h.closeConnections()
+	wg.Wait()
+
+	messages := <-received
+	if len(messages) == 0 || !strings.Contains(messages[len(messages)-1], "server_restarting") {
+		t.Errorf("last message = %v, want server_restarting before the close", messages)
+	}
+}
+
+func TestReadinessFailsOnceShutdownStarts(t *testing.T) {
+	h := NewHub(nil)
+	checker := health.NewChecker(health.DefaultTimeout)
+	checker.Add("websocket", h.Ready, true)
+
+	if report := checker.Check(context.Background()); report.Status != health.StatusOK {
+		t.Fatalf("status before shutdown = %s, want ok", report.Status)
+	}
+
+	<-h.beginShutdown()
+
+	report := checker.Check(context.Background())
+	if report.Status != health.StatusUnavailable {
+		t.Errorf("status during shutdown = %s, want unavailable", report.Status)
+	}
+	if got := report.Checks["websocket"].Error; got != health.ErrorShuttingDown {
+		t.Errorf("websocket check error = %q, want %q", got, health.ErrorShuttingDown)
+	}
+}